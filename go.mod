@@ -105,6 +105,6 @@ require (
 	github.com/rs/zerolog v1.33.0
 	go.uber.org/atomic v1.9.0 // indirect
 	golang.org/x/crypto v0.32.0
-	golang.org/x/text v0.21.0 // indirect
-	google.golang.org/grpc v1.70.0
+	golang.org/x/text v0.21.0
+	google.golang.org/grpc v1.70.0 // indirect
 )
@@ -35,6 +35,21 @@ func (app *App) Run() {
 		closer.Wait()
 	}()
 
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if interval := app.serviceProvider.ReconciliationConfig().Interval(); interval > 0 {
+		go app.runReconciliationJob(ctx, interval)
+	}
+
+	if interval := app.serviceProvider.ShopConfig().PendingTransfersSweepInterval(); interval > 0 {
+		go app.runPendingTransfersJob(ctx, interval)
+	}
+
+	if interval := app.serviceProvider.ShopConfig().CoinExpirationSweepInterval(); interval > 0 {
+		go app.runCoinExpirationJob(ctx, interval)
+	}
+
 	app.runHTTPServer()
 }
 
@@ -70,7 +85,10 @@ func (app *App) initServiceProvider(_ context.Context) error {
 }
 
 func (app *App) initHTTPServer(ctx context.Context) error {
-	router := app.serviceProvider.AppHandler(ctx).InitRoutes()
+	router, err := app.serviceProvider.AppHandler(ctx).InitRoutes()
+	if err != nil {
+		return err
+	}
 
 	app.httpServer = &http.Server{
 		Addr:    app.serviceProvider.ServerConfig().Address(),
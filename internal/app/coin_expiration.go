@@ -0,0 +1,31 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// runCoinExpirationJob периодически списывает с балансов пользователей
+// остатки партий монет, срок действия которых истёк.
+func (app *App) runCoinExpirationJob(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			expired, err := app.serviceProvider.AppService(ctx).Shop.ExpireCoinLots(ctx)
+			if err != nil {
+				log.Error().Err(err).Msg("failed to expire coin lots")
+			}
+
+			if expired != 0 {
+				log.Info().Int("expired", expired).Msg("expired coin lots written off")
+			}
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// runPendingTransfersJob периодически возвращает отправителям монеты
+// по переводам, срок подтверждения которых истёк.
+func (app *App) runPendingTransfersJob(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			expired, err := app.serviceProvider.AppService(ctx).Shop.ExpirePendingTransfers(ctx)
+			if err != nil {
+				log.Error().Err(err).Msg("failed to expire pending transfers")
+			}
+
+			if expired != 0 {
+				log.Info().Int("expired", expired).Msg("pending transfers returned to senders")
+			}
+		}
+	}
+}
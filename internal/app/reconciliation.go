@@ -0,0 +1,53 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// RunReconciliation выполняет разовую сверку балансов и пишет отчёт в формате JSON.
+// Возвращает false, если найдены расхождения.
+func (app *App) RunReconciliation(ctx context.Context, out io.Writer) (bool, error) {
+	report, err := app.serviceProvider.AppService(ctx).Reconciliation.Reconcile(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(report); err != nil {
+		return false, err
+	}
+
+	return len(report.Discrepancies) == 0, nil
+}
+
+// runReconciliationJob периодически сверяет балансы и публикует результат в метриках.
+func (app *App) runReconciliationJob(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			report, err := app.serviceProvider.AppService(ctx).Reconciliation.Reconcile(ctx)
+			if err != nil {
+				log.Error().Err(err).Msg("balance reconciliation failed")
+				continue
+			}
+
+			app.serviceProvider.Metrics().ObserveReconciliation(report)
+
+			if len(report.Discrepancies) != 0 {
+				log.Warn().Interface("report", report).Msg("balance reconciliation found discrepancies")
+			}
+		}
+	}
+}
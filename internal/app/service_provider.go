@@ -11,6 +11,7 @@ import (
 	"github.com/MaksimovDenis/Avito_merch_shop/internal/config"
 	"github.com/MaksimovDenis/Avito_merch_shop/internal/handler"
 	"github.com/MaksimovDenis/Avito_merch_shop/internal/metrics"
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/models"
 	"github.com/MaksimovDenis/Avito_merch_shop/internal/repository"
 	"github.com/MaksimovDenis/Avito_merch_shop/internal/service"
 	"github.com/MaksimovDenis/Avito_merch_shop/pkg/token"
@@ -22,6 +23,11 @@ type serviceProvider struct {
 	pgConfig     config.PGConfig
 	serverConfig config.ServerConfig
 	tokenConfig  config.TokenConfig
+	shopConfig   config.ShopConfig
+
+	reconciliationConfig config.ReconciliationConfig
+	transferLimitsConfig config.TransferLimitsConfig
+	docsConfig           config.DocsConfig
 
 	dbClient      db.Client
 	txManager     db.TxManager
@@ -64,8 +70,11 @@ func (srv *serviceProvider) initLogger() zerolog.Logger {
 	return logger
 }
 
-func (srv *serviceProvider) initMetric() *metrics.Metrics {
-	srv.metrics = metrics.New()
+func (srv *serviceProvider) Metrics() *metrics.Metrics {
+	if srv.metrics == nil {
+		srv.metrics = metrics.New()
+	}
+
 	return srv.metrics
 }
 
@@ -108,6 +117,58 @@ func (srv *serviceProvider) TokenConfig() config.TokenConfig {
 	return srv.tokenConfig
 }
 
+func (srv *serviceProvider) ShopConfig() config.ShopConfig {
+	if srv.shopConfig == nil {
+		cfg, err := config.NewShopConfig()
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to get shop config")
+		}
+
+		srv.shopConfig = cfg
+	}
+
+	return srv.shopConfig
+}
+
+func (srv *serviceProvider) ReconciliationConfig() config.ReconciliationConfig {
+	if srv.reconciliationConfig == nil {
+		cfg, err := config.NewReconciliationConfig()
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to get reconciliation config")
+		}
+
+		srv.reconciliationConfig = cfg
+	}
+
+	return srv.reconciliationConfig
+}
+
+func (srv *serviceProvider) TransferLimitsConfig() config.TransferLimitsConfig {
+	if srv.transferLimitsConfig == nil {
+		cfg, err := config.NewTransferLimitsConfig()
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to get transfer limits config")
+		}
+
+		srv.transferLimitsConfig = cfg
+	}
+
+	return srv.transferLimitsConfig
+}
+
+func (srv *serviceProvider) DocsConfig() config.DocsConfig {
+	if srv.docsConfig == nil {
+		cfg, err := config.NewDocsConfig()
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to get docs config")
+		}
+
+		srv.docsConfig = cfg
+	}
+
+	return srv.docsConfig
+}
+
 func (srv *serviceProvider) DBClient(ctx context.Context) db.Client {
 	if srv.dbClient == nil {
 		client, err := pg.New(ctx, srv.PGConfig().DSN())
@@ -166,6 +227,14 @@ func (srv *serviceProvider) AppService(ctx context.Context) *service.Service {
 			*srv.AppRepository(ctx),
 			srv.DBClient(ctx),
 			*srv.TokenMaker(ctx),
+			srv.ShopConfig().RefundWindow(),
+			srv.ShopConfig().TransferAcceptWindow(),
+			models.TransferLimits{
+				MaxAmount:   srv.TransferLimitsConfig().MaxAmount(),
+				DailyLimit:  srv.TransferLimitsConfig().DailyLimit(),
+				WeeklyLimit: srv.TransferLimitsConfig().WeeklyLimit(),
+				HourlyCount: srv.TransferLimitsConfig().HourlyCount(),
+			},
 			srv.log.With().Str("module", "service").Logger(),
 		)
 	}
@@ -179,8 +248,12 @@ func (srv *serviceProvider) AppHandler(ctx context.Context) *handler.Handler {
 			*srv.AppService(ctx),
 			*srv.TokenMaker(ctx),
 			srv.log.With().Str("module", "api").Logger(),
-			srv.initMetric(),
+			srv.Metrics(),
 		)
+
+		if srv.DocsConfig().Enabled() {
+			srv.handler.EnableDocs()
+		}
 	}
 
 	return srv.handler
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS cart_items (
+    user_id INT NOT NULL,
+    products_id INT NOT NULL,
+    quantity INT NOT NULL CHECK (quantity > 0),
+    PRIMARY KEY (user_id, products_id),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (products_id) REFERENCES products(id) ON DELETE CASCADE
+);
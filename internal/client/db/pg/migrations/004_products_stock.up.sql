@@ -0,0 +1,2 @@
+-- NULL означает неограниченное количество товара.
+ALTER TABLE products ADD COLUMN IF NOT EXISTS stock INT CHECK (stock >= 0);
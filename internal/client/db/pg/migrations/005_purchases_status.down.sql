@@ -0,0 +1,4 @@
+ALTER TABLE purchases
+    DROP COLUMN IF EXISTS total_price,
+    DROP COLUMN IF EXISTS refunded_at,
+    DROP COLUMN IF EXISTS status;
@@ -0,0 +1,13 @@
+ALTER TABLE purchases
+    ADD COLUMN IF NOT EXISTS status VARCHAR(16) NOT NULL DEFAULT 'completed'
+        CHECK (status IN ('completed', 'refunded')),
+    ADD COLUMN IF NOT EXISTS refunded_at TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS total_price INT CHECK (total_price >= 0);
+
+-- Для покупок, совершённых до появления возвратов, берём текущую цену товара.
+UPDATE purchases AS pu
+SET total_price = p.price * pu.quantity
+FROM products AS p
+WHERE pu.products_id = p.id AND pu.total_price IS NULL;
+
+ALTER TABLE purchases ALTER COLUMN total_price SET NOT NULL;
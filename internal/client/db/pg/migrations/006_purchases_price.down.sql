@@ -0,0 +1,2 @@
+ALTER TABLE purchases
+    DROP COLUMN IF EXISTS unit_price;
@@ -0,0 +1,11 @@
+ALTER TABLE purchases
+    ADD COLUMN IF NOT EXISTS unit_price INT CHECK (unit_price >= 0);
+
+-- Сумма покупки сохраняется с момента появления возвратов, цену единицы
+-- восстанавливаем по ней.
+UPDATE purchases
+SET unit_price = total_price / quantity
+WHERE unit_price IS NULL;
+
+ALTER TABLE purchases
+    ALTER COLUMN unit_price SET NOT NULL;
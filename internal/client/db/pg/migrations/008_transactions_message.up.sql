@@ -0,0 +1 @@
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS message VARCHAR(255);
@@ -0,0 +1,6 @@
+DROP TRIGGER IF EXISTS users_coins_match_ledger ON users;
+DROP TABLE IF EXISTS ledger_entries;
+DROP TABLE IF EXISTS ledger_journals;
+DROP FUNCTION IF EXISTS ledger_check_user_balance();
+DROP FUNCTION IF EXISTS ledger_check_journal();
+DROP FUNCTION IF EXISTS ledger_entries_immutable();
@@ -0,0 +1,101 @@
+-- Журнал проводок: каждая операция с монетами записывается в ledger_journals
+-- и сопровождается набором проводок ledger_entries, сумма которых равна нулю.
+-- Счёт 'user' принадлежит пользователю, остальные счета системные:
+-- 'issuance' — выпуск монет, 'shop' — выручка магазина.
+CREATE TABLE IF NOT EXISTS ledger_journals (
+    id SERIAL PRIMARY KEY,
+    operation VARCHAR(32) NOT NULL,
+    reference_id INT,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS ledger_entries (
+    id SERIAL PRIMARY KEY,
+    journal_id INT NOT NULL,
+    account VARCHAR(32) NOT NULL,
+    user_id INT,
+    amount INT NOT NULL CHECK (amount <> 0),
+    CHECK ((account = 'user') = (user_id IS NOT NULL)),
+    FOREIGN KEY (journal_id) REFERENCES ledger_journals(id),
+    FOREIGN KEY (user_id) REFERENCES users(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_ledger_entries_journal_id ON ledger_entries (journal_id);
+CREATE INDEX IF NOT EXISTS idx_ledger_entries_user_id ON ledger_entries (user_id);
+
+-- Текущие балансы переносим в журнал как начальные остатки.
+INSERT INTO ledger_journals (operation, reference_id)
+SELECT 'opening', id FROM users WHERE coins <> 0;
+
+INSERT INTO ledger_entries (journal_id, account, user_id, amount)
+SELECT j.id, 'user', u.id, u.coins
+FROM ledger_journals j
+JOIN users u ON u.id = j.reference_id
+WHERE j.operation = 'opening'
+UNION ALL
+SELECT j.id, 'issuance', NULL, -u.coins
+FROM ledger_journals j
+JOIN users u ON u.id = j.reference_id
+WHERE j.operation = 'opening';
+
+-- Проводки неизменяемы.
+CREATE OR REPLACE FUNCTION ledger_entries_immutable() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'ledger entries are immutable';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER ledger_entries_immutable
+    BEFORE UPDATE OR DELETE ON ledger_entries
+    FOR EACH ROW EXECUTE FUNCTION ledger_entries_immutable();
+
+-- Сумма проводок каждой операции должна быть равна нулю.
+CREATE OR REPLACE FUNCTION ledger_check_journal() RETURNS trigger AS $$
+BEGIN
+    IF (SELECT SUM(amount) FROM ledger_entries WHERE journal_id = NEW.journal_id) <> 0 THEN
+        RAISE EXCEPTION 'ledger journal % is not balanced', NEW.journal_id;
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE CONSTRAINT TRIGGER ledger_entries_balanced
+    AFTER INSERT ON ledger_entries
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW EXECUTE FUNCTION ledger_check_journal();
+
+-- Баланс пользователя должен совпадать с суммой проводок по его счёту
+-- на момент фиксации транзакции.
+CREATE OR REPLACE FUNCTION ledger_check_user_balance() RETURNS trigger AS $$
+DECLARE
+    checked_user_id INT;
+BEGIN
+    IF TG_TABLE_NAME = 'users' THEN
+        checked_user_id := NEW.id;
+    ELSE
+        checked_user_id := NEW.user_id;
+    END IF;
+
+    IF checked_user_id IS NULL THEN
+        RETURN NULL;
+    END IF;
+
+    IF (SELECT coins FROM users WHERE id = checked_user_id) IS DISTINCT FROM
+       (SELECT COALESCE(SUM(amount), 0) FROM ledger_entries WHERE user_id = checked_user_id) THEN
+        RAISE EXCEPTION 'balance of user % does not match the ledger', checked_user_id;
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE CONSTRAINT TRIGGER users_coins_match_ledger
+    AFTER INSERT OR UPDATE OF coins ON users
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW EXECUTE FUNCTION ledger_check_user_balance();
+
+CREATE CONSTRAINT TRIGGER ledger_entries_match_users_coins
+    AFTER INSERT ON ledger_entries
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW EXECUTE FUNCTION ledger_check_user_balance();
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_transactions_pending_expires_at;
+
+ALTER TABLE transactions
+    DROP COLUMN IF EXISTS resolved_at,
+    DROP COLUMN IF EXISTS expires_at,
+    DROP COLUMN IF EXISTS status;
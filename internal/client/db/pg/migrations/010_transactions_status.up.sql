@@ -0,0 +1,11 @@
+-- Перевод с подтверждением создаётся в статусе 'pending': монеты списаны
+-- с отправителя и удерживаются до принятия, отклонения или истечения expires_at.
+-- В журнале проводок удерживаемые монеты учитываются на системном счёте 'escrow'.
+ALTER TABLE transactions
+    ADD COLUMN IF NOT EXISTS status VARCHAR(16) NOT NULL DEFAULT 'completed'
+        CHECK (status IN ('completed', 'pending', 'declined', 'expired')),
+    ADD COLUMN IF NOT EXISTS expires_at TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS resolved_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_transactions_pending_expires_at
+    ON transactions (expires_at) WHERE status = 'pending';
@@ -0,0 +1,20 @@
+-- Запрос монет: requester просит payer перевести сумму amount.
+-- После одобрения transaction_id указывает на выполненный перевод.
+CREATE TABLE IF NOT EXISTS payment_requests (
+    id SERIAL PRIMARY KEY,
+    requester_id INT NOT NULL,
+    payer_id INT NOT NULL,
+    amount INT NOT NULL CHECK (amount > 0),
+    reason VARCHAR(255),
+    status VARCHAR(16) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'approved', 'rejected')),
+    transaction_id INT,
+    created_at TIMESTAMP DEFAULT NOW(),
+    resolved_at TIMESTAMP,
+    CHECK (requester_id <> payer_id),
+    FOREIGN KEY (requester_id) REFERENCES users(id),
+    FOREIGN KEY (payer_id) REFERENCES users(id),
+    FOREIGN KEY (transaction_id) REFERENCES transactions(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_payment_requests_payer_id ON payment_requests (payer_id, id);
@@ -0,0 +1,13 @@
+-- Лимиты переводов, заданные пользователю администратором.
+-- NULL означает лимит из конфигурации, 0 — отсутствие лимита.
+CREATE TABLE IF NOT EXISTS transfer_limits (
+    user_id INT PRIMARY KEY,
+    max_amount INT CHECK (max_amount >= 0),
+    daily_limit INT CHECK (daily_limit >= 0),
+    weekly_limit INT CHECK (weekly_limit >= 0),
+    hourly_count INT CHECK (hourly_count >= 0),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    FOREIGN KEY (user_id) REFERENCES users(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_transactions_sender_id_created_at ON transactions (sender_id, created_at);
@@ -0,0 +1,13 @@
+-- Начисления монет администратором. Каждое начисление записывается
+-- в журнал проводок со счёта 'issuance'.
+CREATE TABLE IF NOT EXISTS coin_issuances (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL,
+    amount INT NOT NULL CHECK (amount > 0),
+    reason VARCHAR(255),
+    issued_by VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    FOREIGN KEY (user_id) REFERENCES users(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_coin_issuances_user_id ON coin_issuances (user_id);
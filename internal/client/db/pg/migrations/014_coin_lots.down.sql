@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS users_coins_match_coin_lots ON users;
+DROP TABLE IF EXISTS coin_lots;
+DROP FUNCTION IF EXISTS coin_lots_check_user_balance();
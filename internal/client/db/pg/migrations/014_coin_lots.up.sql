@@ -0,0 +1,51 @@
+-- Монеты пользователя хранятся партиями: каждое зачисление создаёт партию
+-- со сроком действия 12 месяцев. Списания расходуют партии, начиная с тех,
+-- срок которых истекает раньше, а просроченные остатки списываются фоновой задачей.
+-- users.coins остаётся суммой остатков всех партий пользователя.
+CREATE TABLE IF NOT EXISTS coin_lots (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL,
+    amount INT NOT NULL CHECK (amount > 0),
+    remaining INT NOT NULL CHECK (remaining >= 0 AND remaining <= amount),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMP NOT NULL DEFAULT NOW() + INTERVAL '12 months',
+    FOREIGN KEY (user_id) REFERENCES users(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_coin_lots_user_id ON coin_lots (user_id, expires_at, id) WHERE remaining > 0;
+CREATE INDEX IF NOT EXISTS idx_coin_lots_expires_at ON coin_lots (expires_at) WHERE remaining > 0;
+
+-- Текущие балансы переносим в партии, срок которых отсчитывается с момента миграции.
+INSERT INTO coin_lots (user_id, amount, remaining)
+SELECT id, coins, coins FROM users WHERE coins > 0;
+
+-- Баланс пользователя должен совпадать с суммой остатков его партий
+-- на момент фиксации транзакции.
+CREATE OR REPLACE FUNCTION coin_lots_check_user_balance() RETURNS trigger AS $$
+DECLARE
+    checked_user_id INT;
+BEGIN
+    IF TG_TABLE_NAME = 'users' THEN
+        checked_user_id := NEW.id;
+    ELSE
+        checked_user_id := NEW.user_id;
+    END IF;
+
+    IF (SELECT coins FROM users WHERE id = checked_user_id) IS DISTINCT FROM
+       (SELECT COALESCE(SUM(remaining), 0) FROM coin_lots WHERE user_id = checked_user_id) THEN
+        RAISE EXCEPTION 'balance of user % does not match coin lots', checked_user_id;
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE CONSTRAINT TRIGGER users_coins_match_coin_lots
+    AFTER INSERT OR UPDATE OF coins ON users
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW EXECUTE FUNCTION coin_lots_check_user_balance();
+
+CREATE CONSTRAINT TRIGGER coin_lots_match_users_coins
+    AFTER INSERT OR UPDATE OF remaining ON coin_lots
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW EXECUTE FUNCTION coin_lots_check_user_balance();
@@ -0,0 +1,62 @@
+-- Ограничение coins >= 0 нельзя вернуть, пока у кого-то отрицательный баланс
+-- после принудительной отмены перевода: такой долг нужно сначала погасить.
+DO $$
+DECLARE
+    indebted TEXT;
+BEGIN
+    SELECT string_agg(username || ' (' || coins || ')', ', ' ORDER BY id) INTO indebted
+    FROM users WHERE coins < 0;
+
+    IF indebted IS NOT NULL THEN
+        RAISE EXCEPTION 'cannot revert migration 015: users have negative balances: %', indebted
+            USING HINT = 'Credit these users up to a non-negative balance before rolling back.';
+    END IF;
+END;
+$$;
+
+CREATE OR REPLACE FUNCTION coin_lots_check_user_balance() RETURNS trigger AS $$
+DECLARE
+    checked_user_id INT;
+BEGIN
+    IF TG_TABLE_NAME = 'users' THEN
+        checked_user_id := NEW.id;
+    ELSE
+        checked_user_id := NEW.user_id;
+    END IF;
+
+    IF (SELECT coins FROM users WHERE id = checked_user_id) IS DISTINCT FROM
+       (SELECT COALESCE(SUM(remaining), 0) FROM coin_lots WHERE user_id = checked_user_id) THEN
+        RAISE EXCEPTION 'balance of user % does not match coin lots', checked_user_id;
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS users_check_coins ON users;
+DROP FUNCTION IF EXISTS users_check_coins();
+
+ALTER TABLE users ADD CONSTRAINT users_coins_check CHECK (coins >= 0);
+
+DROP TABLE IF EXISTS transfer_reversals;
+
+ALTER TABLE transactions
+    DROP CONSTRAINT IF EXISTS transactions_reversal_of_check,
+    DROP CONSTRAINT IF EXISTS transactions_status_check;
+
+-- Без reversal_of встречный перевод остаётся обычным выполненным переводом
+-- от получателя отправителю, и балансы по истории сходятся.
+UPDATE transactions SET status = 'completed' WHERE status = 'reversal';
+
+UPDATE payment_requests SET status = 'approved' WHERE status = 'reversed';
+
+ALTER TABLE payment_requests DROP CONSTRAINT IF EXISTS payment_requests_status_check;
+
+ALTER TABLE payment_requests
+    ADD CONSTRAINT payment_requests_status_check
+        CHECK (status IN ('pending', 'approved', 'rejected'));
+
+ALTER TABLE transactions
+    DROP COLUMN IF EXISTS reversal_of,
+    ADD CONSTRAINT transactions_status_check
+        CHECK (status IN ('completed', 'pending', 'declined', 'expired'));
@@ -0,0 +1,73 @@
+-- Отмена перевода администратором: исходный перевод не изменяется, возврат
+-- монет записывается встречным переводом в статусе 'reversal', ссылающимся
+-- на исходный через reversal_of. Каждая отмена сохраняется
+-- в transfer_reversals вместе с администратором и причиной.
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS transactions_status_check;
+
+ALTER TABLE transactions
+    ADD CONSTRAINT transactions_status_check
+        CHECK (status IN ('completed', 'pending', 'declined', 'expired', 'reversal')),
+    ADD COLUMN IF NOT EXISTS reversal_of INT UNIQUE REFERENCES transactions(id),
+    ADD CONSTRAINT transactions_reversal_of_check
+        CHECK ((status = 'reversal') = (reversal_of IS NOT NULL));
+
+CREATE TABLE IF NOT EXISTS transfer_reversals (
+    id SERIAL PRIMARY KEY,
+    transaction_id INT NOT NULL UNIQUE,
+    reversal_id INT NOT NULL UNIQUE,
+    reversed_by VARCHAR(255) NOT NULL,
+    reason VARCHAR(255) NOT NULL,
+    forced BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP DEFAULT NOW(),
+    FOREIGN KEY (transaction_id) REFERENCES transactions(id),
+    FOREIGN KEY (reversal_id) REFERENCES transactions(id)
+);
+
+-- Запрос монет, оплаченный отменённым переводом, получает статус 'reversed'.
+ALTER TABLE payment_requests DROP CONSTRAINT IF EXISTS payment_requests_status_check;
+
+ALTER TABLE payment_requests
+    ADD CONSTRAINT payment_requests_status_check
+        CHECK (status IN ('pending', 'approved', 'rejected', 'reversed'));
+
+-- Принудительная отмена может увести баланс получателя в минус, поэтому
+-- ограничение coins >= 0 заменяется триггером: уменьшать баланс ниже нуля
+-- можно только в транзакции, где установлен shop.allow_negative_balance.
+-- Пополнение отрицательного баланса разрешено всегда.
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_coins_check;
+
+CREATE OR REPLACE FUNCTION users_check_coins() RETURNS trigger AS $$
+BEGIN
+    IF NEW.coins < 0 AND (TG_OP = 'INSERT' OR NEW.coins < OLD.coins)
+       AND current_setting('shop.allow_negative_balance', true) IS DISTINCT FROM 'on' THEN
+        RAISE EXCEPTION 'new row for relation "users" violates check constraint "users_coins_check"'
+            USING ERRCODE = 'check_violation', TABLE = 'users', CONSTRAINT = 'users_coins_check';
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER users_check_coins
+    BEFORE INSERT OR UPDATE OF coins ON users
+    FOR EACH ROW EXECUTE FUNCTION users_check_coins();
+
+-- Партии монет покрывают только положительную часть баланса.
+CREATE OR REPLACE FUNCTION coin_lots_check_user_balance() RETURNS trigger AS $$
+DECLARE
+    checked_user_id INT;
+BEGIN
+    IF TG_TABLE_NAME = 'users' THEN
+        checked_user_id := NEW.id;
+    ELSE
+        checked_user_id := NEW.user_id;
+    END IF;
+
+    IF (SELECT GREATEST(coins, 0) FROM users WHERE id = checked_user_id) IS DISTINCT FROM
+       (SELECT COALESCE(SUM(remaining), 0) FROM coin_lots WHERE user_id = checked_user_id) THEN
+        RAISE EXCEPTION 'balance of user % does not match coin lots', checked_user_id;
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,19 @@
+-- Части партий монет, израсходованные покупкой или переводом. По ним монеты,
+-- вернувшиеся отправителю или покупателю, получают исходный срок действия,
+-- а получатель перевода — срок действия партий отправителя.
+CREATE TABLE IF NOT EXISTS coin_lot_spends (
+    id SERIAL PRIMARY KEY,
+    lot_id INT NOT NULL,
+    amount INT NOT NULL CHECK (amount > 0),
+    purchase_id INT,
+    transaction_id INT,
+    CHECK ((purchase_id IS NULL) <> (transaction_id IS NULL)),
+    FOREIGN KEY (lot_id) REFERENCES coin_lots(id),
+    FOREIGN KEY (purchase_id) REFERENCES purchases(id),
+    FOREIGN KEY (transaction_id) REFERENCES transactions(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_coin_lot_spends_purchase_id ON coin_lot_spends (purchase_id)
+    WHERE purchase_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_coin_lot_spends_transaction_id ON coin_lot_spends (transaction_id)
+    WHERE transaction_id IS NOT NULL;
@@ -0,0 +1,4 @@
+-- Ресурс, созданный запросом с ключом идемпотентности: покупка или перевод.
+-- Повторный запрос с тем же ключом возвращает этот ресурс. Для ключей,
+-- сохранённых до миграции, и для пакетных переводов ресурс не записывается.
+ALTER TABLE idempotency_keys ADD COLUMN IF NOT EXISTS resource_id INT;
@@ -0,0 +1,3 @@
+-- Переводы, созданные пакетным запросом с ключом идемпотентности, в порядке
+-- получателей. Повторный запрос с тем же ключом возвращает эти переводы.
+ALTER TABLE idempotency_keys ADD COLUMN IF NOT EXISTS resource_ids INT[];
@@ -0,0 +1,41 @@
+package config
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+const docsEnabledEnvName = "DOCS_ENABLED"
+
+type DocsConfig interface {
+	// Enabled сообщает, доступна ли страница документации API /docs.
+	// По умолчанию страница выключена.
+	Enabled() bool
+}
+
+type docsConfig struct {
+	enabled bool
+}
+
+func NewDocsConfig() (DocsConfig, error) {
+	enabled := false
+
+	if value := os.Getenv(docsEnabledEnvName); len(value) != 0 {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse docs enabled flag")
+		}
+
+		enabled = parsed
+	}
+
+	return &docsConfig{
+		enabled: enabled,
+	}, nil
+}
+
+func (cfg *docsConfig) Enabled() bool {
+	return cfg.enabled
+}
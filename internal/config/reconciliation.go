@@ -0,0 +1,45 @@
+package config
+
+import (
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const reconcileIntervalEnvName = "RECONCILE_INTERVAL"
+
+type ReconciliationConfig interface {
+	// Interval возвращает период сверки балансов. Ноль означает, что
+	// периодическая сверка отключена.
+	Interval() time.Duration
+}
+
+type reconciliationConfig struct {
+	interval time.Duration
+}
+
+func NewReconciliationConfig() (ReconciliationConfig, error) {
+	var interval time.Duration
+
+	if value := os.Getenv(reconcileIntervalEnvName); len(value) != 0 {
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse reconcile interval")
+		}
+
+		if parsed < 0 {
+			return nil, errors.New("reconcile interval must not be negative")
+		}
+
+		interval = parsed
+	}
+
+	return &reconciliationConfig{
+		interval: interval,
+	}, nil
+}
+
+func (cfg *reconciliationConfig) Interval() time.Duration {
+	return cfg.interval
+}
@@ -0,0 +1,106 @@
+package config
+
+import (
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	refundWindowEnvName          = "REFUND_WINDOW"
+	transferAcceptWindowEnvName  = "TRANSFER_ACCEPT_WINDOW"
+	pendingTransfersSweepEnvName = "PENDING_TRANSFERS_SWEEP_INTERVAL"
+	coinExpirationSweepEnvName   = "COIN_EXPIRATION_SWEEP_INTERVAL"
+
+	defaultRefundWindow          = 14 * 24 * time.Hour
+	defaultTransferAcceptWindow  = 3 * 24 * time.Hour
+	defaultPendingTransfersSweep = time.Minute
+	defaultCoinExpirationSweep   = time.Hour
+)
+
+type ShopConfig interface {
+	RefundWindow() time.Duration
+	// TransferAcceptWindow возвращает срок, в течение которого получатель
+	// может принять перевод с подтверждением.
+	TransferAcceptWindow() time.Duration
+	// PendingTransfersSweepInterval возвращает период возврата монет по
+	// просроченным переводам. Ноль означает, что возврат отключён.
+	PendingTransfersSweepInterval() time.Duration
+	// CoinExpirationSweepInterval возвращает период списания сгоревших
+	// партий монет. Ноль означает, что списание отключено.
+	CoinExpirationSweepInterval() time.Duration
+}
+
+type shopConfig struct {
+	refundWindow                  time.Duration
+	transferAcceptWindow          time.Duration
+	pendingTransfersSweepInterval time.Duration
+	coinExpirationSweepInterval   time.Duration
+}
+
+func NewShopConfig() (ShopConfig, error) {
+	refundWindow, err := durationFromEnv(refundWindowEnvName, defaultRefundWindow)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse refund window")
+	}
+
+	transferAcceptWindow, err := durationFromEnv(transferAcceptWindowEnvName, defaultTransferAcceptWindow)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse transfer accept window")
+	}
+
+	pendingTransfersSweepInterval, err := durationFromEnv(pendingTransfersSweepEnvName,
+		defaultPendingTransfersSweep)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse pending transfers sweep interval")
+	}
+
+	coinExpirationSweepInterval, err := durationFromEnv(coinExpirationSweepEnvName, defaultCoinExpirationSweep)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse coin expiration sweep interval")
+	}
+
+	return &shopConfig{
+		refundWindow:                  refundWindow,
+		transferAcceptWindow:          transferAcceptWindow,
+		pendingTransfersSweepInterval: pendingTransfersSweepInterval,
+		coinExpirationSweepInterval:   coinExpirationSweepInterval,
+	}, nil
+}
+
+func (cfg *shopConfig) RefundWindow() time.Duration {
+	return cfg.refundWindow
+}
+
+func (cfg *shopConfig) TransferAcceptWindow() time.Duration {
+	return cfg.transferAcceptWindow
+}
+
+func (cfg *shopConfig) PendingTransfersSweepInterval() time.Duration {
+	return cfg.pendingTransfersSweepInterval
+}
+
+func (cfg *shopConfig) CoinExpirationSweepInterval() time.Duration {
+	return cfg.coinExpirationSweepInterval
+}
+
+// durationFromEnv читает неотрицательную длительность из переменной окружения
+// или возвращает значение по умолчанию, если переменная не задана.
+func durationFromEnv(envName string, defaultValue time.Duration) (time.Duration, error) {
+	value := os.Getenv(envName)
+	if len(value) == 0 {
+		return defaultValue, nil
+	}
+
+	parsed, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+
+	if parsed < 0 {
+		return 0, errors.Errorf("%s must not be negative", envName)
+	}
+
+	return parsed, nil
+}
@@ -0,0 +1,81 @@
+package config
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	transferMaxAmountEnvName   = "TRANSFER_MAX_AMOUNT"
+	transferDailyLimitEnvName  = "TRANSFER_DAILY_LIMIT"
+	transferWeeklyLimitEnvName = "TRANSFER_WEEKLY_LIMIT"
+	transferHourlyCountEnvName = "TRANSFER_HOURLY_COUNT"
+)
+
+// TransferLimitsConfig содержит лимиты переводов по умолчанию.
+// Ноль означает, что лимит не ограничен.
+type TransferLimitsConfig interface {
+	MaxAmount() int
+	DailyLimit() int
+	WeeklyLimit() int
+	HourlyCount() int
+}
+
+type transferLimitsConfig struct {
+	maxAmount   int
+	dailyLimit  int
+	weeklyLimit int
+	hourlyCount int
+}
+
+func NewTransferLimitsConfig() (TransferLimitsConfig, error) {
+	cfg := &transferLimitsConfig{}
+
+	limits := []struct {
+		envName string
+		value   *int
+	}{
+		{envName: transferMaxAmountEnvName, value: &cfg.maxAmount},
+		{envName: transferDailyLimitEnvName, value: &cfg.dailyLimit},
+		{envName: transferWeeklyLimitEnvName, value: &cfg.weeklyLimit},
+		{envName: transferHourlyCountEnvName, value: &cfg.hourlyCount},
+	}
+
+	for _, limit := range limits {
+		value := os.Getenv(limit.envName)
+		if len(value) == 0 {
+			continue
+		}
+
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse %s", limit.envName)
+		}
+
+		if parsed < 0 {
+			return nil, errors.Errorf("%s must not be negative", limit.envName)
+		}
+
+		*limit.value = parsed
+	}
+
+	return cfg, nil
+}
+
+func (cfg *transferLimitsConfig) MaxAmount() int {
+	return cfg.maxAmount
+}
+
+func (cfg *transferLimitsConfig) DailyLimit() int {
+	return cfg.dailyLimit
+}
+
+func (cfg *transferLimitsConfig) WeeklyLimit() int {
+	return cfg.weeklyLimit
+}
+
+func (cfg *transferLimitsConfig) HourlyCount() int {
+	return cfg.hourlyCount
+}
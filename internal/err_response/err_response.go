@@ -3,20 +3,181 @@ package errresponse
 import (
 	"errors"
 	"fmt"
-	"strings"
+
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/i18n"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+)
+
+// Виды ошибок предметной области. Ошибки сервисов и репозиториев оборачивают
+// один из них, и обработчики выбирают по нему HTTP-статус через errors.Is.
+// Ошибка без вида считается внутренней.
+var (
+	ErrNotFound          = errors.New("не найдено")
+	ErrValidation        = errors.New("некорректный запрос")
+	ErrInsufficientFunds = errors.New("недостаточно средств")
+	ErrConflict          = errors.New("конфликт")
+	ErrUnauthorized      = errors.New("неавторизован")
+)
+
+// ErrOutOfStock — вид ошибки покупки товара, которого нет в наличии.
+var ErrOutOfStock = fmt.Errorf("нет в наличии: %w", ErrConflict)
+
+// Коды ошибок PostgreSQL, которые относятся к предметной области.
+const (
+	checkViolationCode  = "23514"
+	uniqueViolationCode = "23505"
+
+	// usersCoinsConstraint нарушается, когда баланса пользователя не хватает для списания.
+	usersCoinsConstraint = "users_coins_check"
+)
+
+// Localized — ошибка с кодом сообщения из каталога i18n, которую можно
+// показать пользователю на его языке.
+type Localized interface {
+	error
+	ErrorCode() string
+	Localize(lang string) string
+}
+
+// domainError содержит код сообщения для пользователя, аргументы сообщения и вид ошибки.
+type domainError struct {
+	code string
+	args []any
+	kind error
+}
+
+func (e *domainError) Error() string {
+	return e.Localize(i18n.DefaultLanguage)
+}
+
+func (e *domainError) ErrorCode() string {
+	return e.code
+}
+
+func (e *domainError) Localize(lang string) string {
+	return i18n.Message(lang, e.code, e.args...)
+}
+
+func (e *domainError) Unwrap() error {
+	return e.kind
+}
+
+func newDomainError(kind error, code string, args ...any) error {
+	return &domainError{code: code, args: args, kind: kind}
+}
+
+// NotFound возвращает ошибку вида ErrNotFound с кодом сообщения code.
+func NotFound(code string, args ...any) error {
+	return newDomainError(ErrNotFound, code, args...)
+}
+
+// Validation возвращает ошибку вида ErrValidation с кодом сообщения code.
+func Validation(code string, args ...any) error {
+	return newDomainError(ErrValidation, code, args...)
+}
+
+// InsufficientFunds возвращает ошибку вида ErrInsufficientFunds с кодом сообщения code.
+func InsufficientFunds(code string, args ...any) error {
+	return newDomainError(ErrInsufficientFunds, code, args...)
+}
+
+// Conflict возвращает ошибку вида ErrConflict с кодом сообщения code.
+func Conflict(code string, args ...any) error {
+	return newDomainError(ErrConflict, code, args...)
+}
+
+// Unauthorized возвращает ошибку вида ErrUnauthorized с кодом сообщения code.
+func Unauthorized(code string, args ...any) error {
+	return newDomainError(ErrUnauthorized, code, args...)
+}
+
+// OutOfStock возвращает ошибку вида ErrOutOfStock для товара productName.
+func OutOfStock(productName string) error {
+	return newDomainError(ErrOutOfStock, "out_of_stock", productName)
+}
+
+// Коды превышенных лимитов переводов.
+const (
+	TransferLimitMaxAmount   = "transfer_max_amount"
+	TransferLimitDaily       = "transfer_daily_limit"
+	TransferLimitWeekly      = "transfer_weekly_limit"
+	TransferLimitHourlyCount = "transfer_hourly_count"
 )
 
+// TransferLimitError сообщает, что перевод превышает лимит отправителя.
+// Code содержит машиночитаемый код лимита, Limit — его значение.
+type TransferLimitError struct {
+	Code  string
+	Limit int
+}
+
+func (e *TransferLimitError) Error() string {
+	return e.Localize(i18n.DefaultLanguage)
+}
+
+func (e *TransferLimitError) ErrorCode() string {
+	switch e.Code {
+	case TransferLimitMaxAmount, TransferLimitDaily, TransferLimitWeekly, TransferLimitHourlyCount:
+		return e.Code
+	default:
+		return "transfer_limit"
+	}
+}
+
+func (e *TransferLimitError) Localize(lang string) string {
+	return i18n.Message(lang, e.ErrorCode(), e.Limit)
+}
+
+// responseError содержит код сообщения для пользователя, вид ошибки и исходную
+// ошибку, которые можно проверить через errors.Is и errors.As.
+type responseError struct {
+	code string
+	args []any
+	kind error
+	err  error
+}
+
+func (e *responseError) Error() string {
+	return e.Localize(i18n.DefaultLanguage)
+}
+
+func (e *responseError) ErrorCode() string {
+	return e.code
+}
+
+func (e *responseError) Localize(lang string) string {
+	if e.args == nil {
+		return i18n.Message(lang, e.code)
+	}
+
+	return i18n.Message(lang, e.code, i18n.Translate(lang, e.args))
+}
+
+func (e *responseError) Unwrap() []error {
+	if e.kind == nil {
+		return []error{e.err}
+	}
+
+	return []error{e.kind, e.err}
+}
+
+// ErrResponse преобразует ошибку базы данных в ошибку для пользователя.
+// Вид ошибки определяется по pgx.ErrNoRows и коду ошибки PostgreSQL,
+// args подставляются в сообщение о ненайденной записи.
 func ErrResponse(err error, args ...any) error {
-	var errorMessage string
+	var pgErr *pgconn.PgError
 
 	switch {
-	case strings.Contains(err.Error(), "violates check constraint"):
-		errorMessage = "недостаточно средств для покупки"
-	case strings.Contains(err.Error(), "no rows in result set"):
-		errorMessage = fmt.Sprintf("%v не найден", args)
+	case errors.Is(err, pgx.ErrNoRows):
+		return &responseError{code: "not_found", args: append([]any{}, args...), kind: ErrNotFound, err: err}
+	case errors.As(err, &pgErr) && pgErr.Code == checkViolationCode && pgErr.ConstraintName == usersCoinsConstraint:
+		return &responseError{code: "insufficient_funds", kind: ErrInsufficientFunds, err: err}
+	case errors.As(err, &pgErr) && pgErr.Code == checkViolationCode:
+		return &responseError{code: "invalid_data", kind: ErrValidation, err: err}
+	case errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode:
+		return &responseError{code: "already_exists", kind: ErrConflict, err: err}
 	default:
-		errorMessage = "ошибка при обновлении данных"
+		return &responseError{code: "database_error", err: err}
 	}
-
-	return errors.New(errorMessage)
 }
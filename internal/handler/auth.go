@@ -13,7 +13,7 @@ func (hdl *Handler) PostApiAuth(ctx *gin.Context) {
 
 	if err := ctx.BindJSON(&authReq); err != nil {
 		hdl.log.Error().Err(err).Msg("failed to parse request body")
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Неверный запрос"})
+		ctx.JSON(http.StatusBadRequest, errorBody(ctx, "bad_request"))
 
 		return
 	}
@@ -26,7 +26,7 @@ func (hdl *Handler) PostApiAuth(ctx *gin.Context) {
 	token, err := hdl.appService.Authorization.Auth(ctx, modelReq)
 	if err != nil {
 		hdl.log.Error().Err(err).Msg("failed to auth user")
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		errorResponse(ctx, err)
 
 		return
 	}
@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/MaksimovDenis/Avito_merch_shop/pkg/protocol/oapi"
+	"github.com/MaksimovDenis/Avito_merch_shop/pkg/token"
+	"github.com/gin-gonic/gin"
+)
+
+func (hdl *Handler) GetApiCart(ctx *gin.Context) {
+	claims, ok := ctx.Get("user")
+	if !ok {
+		hdl.log.Error().Msg("user claims not found in context")
+		ctx.JSON(http.StatusUnauthorized, errorBody(ctx, "unauthorized"))
+
+		return
+	}
+
+	userId := claims.(*token.UserClaims).ID
+
+	items, total, err := hdl.appService.Shop.Cart(ctx, int(userId))
+	if err != nil {
+		errorResponse(ctx, err)
+		return
+	}
+
+	cartItems := make([]oapi.CartItem, 0, len(items))
+
+	for _, item := range items {
+		itemType := item.Name
+		quantity := item.Quantity
+		price := item.Price
+		itemTotal := item.Price * item.Quantity
+
+		cartItems = append(cartItems, oapi.CartItem{
+			Type:     &itemType,
+			Quantity: &quantity,
+			Price:    &price,
+			Total:    &itemTotal,
+		})
+	}
+
+	res := oapi.CartResponse{
+		Items: &cartItems,
+		Total: &total,
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+func (hdl *Handler) PostApiCartItems(ctx *gin.Context) {
+	var cartItemReq oapi.CartItemRequest
+
+	if err := ctx.BindJSON(&cartItemReq); err != nil {
+		hdl.log.Error().Err(err).Msg("failed to parse request body")
+		ctx.JSON(http.StatusBadRequest, errorBody(ctx, "bad_request"))
+
+		return
+	}
+
+	claims, ok := ctx.Get("user")
+	if !ok {
+		hdl.log.Error().Msg("user claims not found in context")
+		ctx.JSON(http.StatusUnauthorized, errorBody(ctx, "unauthorized"))
+
+		return
+	}
+
+	userId := claims.(*token.UserClaims).ID
+
+	err := hdl.appService.Shop.AddToCart(ctx, int(userId), cartItemReq.Item, cartItemReq.Quantity)
+	if err != nil {
+		errorResponse(ctx, err)
+		return
+	}
+
+	hdl.log.Info().Msgf("userId %v added %v x%v to cart", userId, cartItemReq.Item, cartItemReq.Quantity)
+
+	ctx.JSON(http.StatusOK, gin.H{"message": localize(ctx, "cart_item_added")})
+}
+
+func (hdl *Handler) DeleteApiCartItemsItem(ctx *gin.Context, productName string) {
+	claims, ok := ctx.Get("user")
+	if !ok {
+		hdl.log.Error().Msg("user claims not found in context")
+		ctx.JSON(http.StatusUnauthorized, errorBody(ctx, "unauthorized"))
+
+		return
+	}
+
+	userId := claims.(*token.UserClaims).ID
+
+	if err := hdl.appService.Shop.RemoveFromCart(ctx, int(userId), productName); err != nil {
+		errorResponse(ctx, err)
+		return
+	}
+
+	hdl.log.Info().Msgf("userId %v removed %v from cart", userId, productName)
+
+	ctx.JSON(http.StatusOK, gin.H{"message": localize(ctx, "cart_item_removed")})
+}
+
+func (hdl *Handler) PostApiCartCheckout(ctx *gin.Context) {
+	claims, ok := ctx.Get("user")
+	if !ok {
+		hdl.log.Error().Msg("user claims not found in context")
+		ctx.JSON(http.StatusUnauthorized, errorBody(ctx, "unauthorized"))
+
+		return
+	}
+
+	userId := claims.(*token.UserClaims).ID
+
+	if err := hdl.appService.Shop.Checkout(ctx, int(userId)); err != nil {
+		errorResponse(ctx, err)
+		return
+	}
+
+	hdl.log.Info().Msgf("userId %v checked out cart", userId)
+
+	ctx.JSON(http.StatusOK, gin.H{"message": localize(ctx, "order_placed")})
+}
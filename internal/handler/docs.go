@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"embed"
+	"io/fs"
+	"net/http"
+
+	"github.com/MaksimovDenis/Avito_merch_shop/pkg/protocol/oapi"
+	"github.com/MaksimovDenis/Avito_merch_shop/pkg/protocol/oapiv2"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gin-gonic/gin"
+)
+
+// docsFiles содержит страницу документации API. Страница не загружает ничего
+// со сторонних адресов и работает без доступа к CDN.
+//
+//go:embed docs
+var docsFiles embed.FS
+
+// registerSpecRoutes отдаёт схемы первой (/openapi.*) и второй (/v2/openapi.*)
+// версий API в форматах YAML и JSON, а при docsEnabled ещё и страницу
+// документации /docs.
+func registerSpecRoutes(router *gin.Engine, docsEnabled bool) error {
+	if err := registerSpec(router, "/", oapi.Spec, oapi.GetSwagger); err != nil {
+		return err
+	}
+
+	if err := registerSpec(router, "/v2/", oapiv2.Spec, oapiv2.GetSwagger); err != nil {
+		return err
+	}
+
+	if !docsEnabled {
+		return nil
+	}
+
+	docs, err := fs.Sub(docsFiles, "docs")
+	if err != nil {
+		return err
+	}
+
+	router.StaticFS("/docs", http.FS(docs))
+
+	return nil
+}
+
+func registerSpec(router *gin.Engine, prefix string, spec []byte, getSwagger func() (*openapi3.T, error)) error {
+	swagger, err := getSwagger()
+	if err != nil {
+		return err
+	}
+
+	specJSON, err := swagger.MarshalJSON()
+	if err != nil {
+		return err
+	}
+
+	router.GET(prefix+"openapi.yaml", func(ctx *gin.Context) {
+		ctx.Data(http.StatusOK, "application/yaml; charset=utf-8", spec)
+	})
+	router.GET(prefix+"openapi.json", func(ctx *gin.Context) {
+		ctx.Data(http.StatusOK, "application/json; charset=utf-8", specJSON)
+	})
+
+	return nil
+}
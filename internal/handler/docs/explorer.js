@@ -0,0 +1,289 @@
+// Страница документации API: читает схему выбранной версии API, выводит список операций
+// и позволяет отправить запрос прямо из браузера. Сторонние библиотеки
+// не используются, чтобы страница работала без доступа к CDN.
+(function () {
+  "use strict";
+
+  var methods = ["get", "post", "put", "patch", "delete"];
+  var specURLs = { "1": "/openapi.json", "2": "/v2/openapi.json" };
+  var specURL = specURLs[new URLSearchParams(location.search).get("v")] || specURLs["2"];
+  var tokenInput = document.getElementById("token");
+  var filterInput = document.getElementById("filter");
+  var container = document.getElementById("operations");
+  var spec;
+
+  tokenInput.value = localStorage.getItem("apiToken") || "";
+  tokenInput.addEventListener("input", function () {
+    localStorage.setItem("apiToken", tokenInput.value.trim());
+  });
+
+  filterInput.addEventListener("input", function () {
+    var query = filterInput.value.trim().toLowerCase();
+
+    container.querySelectorAll("details.operation").forEach(function (el) {
+      el.hidden = query !== "" && el.dataset.search.indexOf(query) === -1;
+    });
+  });
+
+  fetch(specURL)
+    .then(function (res) {
+      if (!res.ok) {
+        throw new Error("HTTP " + res.status);
+      }
+
+      return res.json();
+    })
+    .then(function (data) {
+      spec = data;
+      render();
+    })
+    .catch(function (err) {
+      container.textContent = "Не удалось загрузить схему: " + err.message;
+    });
+
+  function el(tag, attrs, children) {
+    var node = document.createElement(tag);
+
+    Object.keys(attrs || {}).forEach(function (key) {
+      if (key === "text") {
+        node.textContent = attrs[key];
+      } else {
+        node.setAttribute(key, attrs[key]);
+      }
+    });
+
+    (children || []).forEach(function (child) {
+      node.appendChild(child);
+    });
+
+    return node;
+  }
+
+  function resolve(obj) {
+    if (!obj || !obj.$ref) {
+      return obj;
+    }
+
+    return obj.$ref.replace(/^#\//, "").split("/").reduce(function (acc, part) {
+      return acc[part];
+    }, spec);
+  }
+
+  // example строит пример значения по схеме для заполнения тела запроса.
+  function example(schema, depth) {
+    schema = resolve(schema) || {};
+
+    if (schema.example !== undefined) {
+      return schema.example;
+    }
+
+    if (depth > 5) {
+      return null;
+    }
+
+    if (schema.enum) {
+      return schema.enum[0];
+    }
+
+    switch (schema.type) {
+      case "object": {
+        var result = {};
+
+        Object.keys(schema.properties || {}).forEach(function (name) {
+          result[name] = example(schema.properties[name], depth + 1);
+        });
+
+        return result;
+      }
+      case "array":
+        return [example(schema.items, depth + 1)];
+      case "integer":
+      case "number":
+        return 0;
+      case "boolean":
+        return false;
+      default:
+        return schema.format === "date-time" ? new Date().toISOString() : "";
+    }
+  }
+
+  function render() {
+    document.getElementById("title").textContent = spec.info.title + " " + spec.info.version;
+    container.textContent = "";
+
+    Object.keys(spec.paths).sort().forEach(function (path) {
+      var item = spec.paths[path];
+
+      methods.forEach(function (method) {
+        if (item[method]) {
+          container.appendChild(renderOperation(path, method, item[method], item.parameters || []));
+        }
+      });
+    });
+  }
+
+  function renderOperation(path, method, op, pathParams) {
+    var params = pathParams.concat(op.parameters || []).map(resolve);
+    var summary = el("summary", {}, [
+      el("span", { "class": "method " + method, text: method }),
+      el("span", { "class": "path", text: path }),
+      el("span", { "class": "summary", text: op.summary || "" })
+    ]);
+
+    if (path.indexOf("/api/admin/") === 0) {
+      summary.appendChild(el("span", { "class": "admin", text: "только администратор" }));
+    }
+
+    var body = el("div", { "class": "body" });
+    var details = el("details", { "class": "operation" }, [summary, body]);
+
+    details.dataset.search = (method + " " + path + " " + (op.summary || "")).toLowerCase();
+
+    if (op.description) {
+      body.appendChild(el("p", { text: op.description }));
+    }
+
+    var inputs = {};
+
+    if (params.length > 0) {
+      var rows = params.map(function (param) {
+        var input = el("input", { type: "text", placeholder: (resolve(param.schema) || {}).type || "" });
+
+        inputs[param.in + ":" + param.name] = { param: param, input: input };
+
+        return el("tr", {}, [
+          el("td", { text: param.name + (param.required ? " *" : "") }),
+          el("td", { text: param.in }),
+          el("td", {}, [input]),
+          el("td", { text: param.description || "" })
+        ]);
+      });
+
+      body.appendChild(el("h3", { text: "Параметры" }));
+      body.appendChild(el("table", {}, rows));
+    }
+
+    var content = op.requestBody ? resolve(op.requestBody).content || {} : {};
+    var bodyInput;
+    var contentType;
+
+    if (content["application/json"]) {
+      contentType = "application/json";
+      bodyInput = el("textarea");
+      bodyInput.value = JSON.stringify(example(content["application/json"].schema, 0), null, 2);
+
+      body.appendChild(el("h3", { text: "Тело запроса" }));
+      body.appendChild(bodyInput);
+    } else if (content["multipart/form-data"]) {
+      contentType = "multipart/form-data";
+      bodyInput = el("form");
+
+      var schema = resolve(content["multipart/form-data"].schema) || {};
+
+      Object.keys(schema.properties || {}).forEach(function (name) {
+        var prop = resolve(schema.properties[name]);
+        var field = el("input", { name: name, type: prop.format === "binary" ? "file" : "text" });
+
+        bodyInput.appendChild(el("label", { text: name + " " }, [field]));
+        bodyInput.appendChild(el("br"));
+      });
+
+      body.appendChild(el("h3", { text: "Тело запроса" }));
+      body.appendChild(bodyInput);
+    }
+
+    body.appendChild(el("h3", { text: "Ответы" }));
+    body.appendChild(el("table", {}, Object.keys(op.responses || {}).map(function (status) {
+      return el("tr", {}, [
+        el("td", { text: status }),
+        el("td", { text: resolve(op.responses[status]).description || "" })
+      ]);
+    })));
+
+    var output = el("div");
+    var send = el("button", { type: "button", text: "Отправить" });
+
+    send.addEventListener("click", function () {
+      sendRequest(path, method, inputs, contentType, bodyInput, output);
+    });
+
+    body.appendChild(send);
+    body.appendChild(output);
+
+    return details;
+  }
+
+  function sendRequest(path, method, inputs, contentType, bodyInput, output) {
+    var url = path;
+    var query = new URLSearchParams();
+    var headers = {};
+    var body;
+
+    Object.keys(inputs).forEach(function (key) {
+      var param = inputs[key].param;
+      var value = inputs[key].input.value;
+
+      if (value === "") {
+        return;
+      }
+
+      switch (param.in) {
+        case "path":
+          url = url.replace("{" + param.name + "}", encodeURIComponent(value));
+          break;
+        case "query":
+          query.append(param.name, value);
+          break;
+        case "header":
+          headers[param.name] = value;
+          break;
+      }
+    });
+
+    if (query.toString() !== "") {
+      url += "?" + query.toString();
+    }
+
+    if (tokenInput.value.trim() !== "") {
+      headers.Authorization = "Bearer " + tokenInput.value.trim();
+    }
+
+    if (contentType === "application/json") {
+      headers["Content-Type"] = contentType;
+      body = bodyInput.value;
+    } else if (contentType === "multipart/form-data") {
+      body = new FormData(bodyInput);
+    }
+
+    output.textContent = "";
+
+    fetch(url, { method: method.toUpperCase(), headers: headers, body: body })
+      .then(function (res) {
+        return res.text().then(function (text) {
+          var pretty = text;
+
+          try {
+            var data = JSON.parse(text);
+
+            pretty = JSON.stringify(data, null, 2);
+
+            // Токен из ответа на авторизацию сразу подставляется в поле.
+            if (/^\/api(\/v2)?\/auth$/.test(path) && res.ok && data.token) {
+              tokenInput.value = data.token;
+              localStorage.setItem("apiToken", data.token);
+            }
+          } catch (e) {
+            // Ответ не в формате JSON выводится как есть.
+          }
+
+          output.appendChild(el("h3", {}, [
+            el("span", { "class": "status" + (res.ok ? "" : " error"), text: res.status + " " + res.statusText })
+          ]));
+          output.appendChild(el("pre", { text: pretty }));
+        });
+      })
+      .catch(function (err) {
+        output.appendChild(el("pre", { text: "Ошибка запроса: " + err.message }));
+      });
+  }
+})();
@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/MaksimovDenis/Avito_merch_shop/pkg/protocol/oapi"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpecRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		docsEnabled    bool
+		path           string
+		expectedStatus int
+		expectedType   string
+	}{
+		{
+			name:           "YAML spec",
+			path:           "/openapi.yaml",
+			expectedStatus: http.StatusOK,
+			expectedType:   "application/yaml; charset=utf-8",
+		},
+		{
+			name:           "JSON spec",
+			path:           "/openapi.json",
+			expectedStatus: http.StatusOK,
+			expectedType:   "application/json; charset=utf-8",
+		},
+		{
+			name:           "v2 YAML spec",
+			path:           "/v2/openapi.yaml",
+			expectedStatus: http.StatusOK,
+			expectedType:   "application/yaml; charset=utf-8",
+		},
+		{
+			name:           "v2 JSON spec",
+			path:           "/v2/openapi.json",
+			expectedStatus: http.StatusOK,
+			expectedType:   "application/json; charset=utf-8",
+		},
+		{
+			name:           "Docs page",
+			docsEnabled:    true,
+			path:           "/docs/",
+			expectedStatus: http.StatusOK,
+			expectedType:   "text/html; charset=utf-8",
+		},
+		{
+			name:           "Docs script",
+			docsEnabled:    true,
+			path:           "/docs/explorer.js",
+			expectedStatus: http.StatusOK,
+			expectedType:   "text/javascript; charset=utf-8",
+		},
+		{
+			name:           "Docs disabled",
+			path:           "/docs/",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Spec with docs disabled",
+			path:           "/openapi.json",
+			expectedStatus: http.StatusOK,
+			expectedType:   "application/json; charset=utf-8",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			require.NoError(t, registerSpecRoutes(router, tt.docsEnabled))
+
+			responseRecord := httptest.NewRecorder()
+			router.ServeHTTP(responseRecord, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			assert.Equal(t, tt.expectedStatus, responseRecord.Code)
+
+			if tt.expectedType != "" {
+				assert.Equal(t, tt.expectedType, responseRecord.Header().Get("Content-Type"))
+			}
+		})
+	}
+}
+
+func TestSpecJSONMatchesYAML(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	require.NoError(t, registerSpecRoutes(router, false))
+
+	responseRecord := httptest.NewRecorder()
+	router.ServeHTTP(responseRecord, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+
+	var spec struct {
+		Paths map[string]any `json:"paths"`
+	}
+	require.NoError(t, json.Unmarshal(responseRecord.Body.Bytes(), &spec))
+
+	swagger, err := oapi.GetSwagger()
+	require.NoError(t, err)
+
+	assert.Len(t, spec.Paths, swagger.Paths.Len())
+	assert.Contains(t, spec.Paths, "/api/auth")
+	assert.Contains(t, string(oapi.Spec), "/api/auth:")
+}
@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	errresponse "github.com/MaksimovDenis/Avito_merch_shop/internal/err_response"
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/i18n"
+	"github.com/MaksimovDenis/Avito_merch_shop/pkg/protocol/oapi"
+	"github.com/gin-gonic/gin"
+)
+
+// language возвращает язык ответа, выбранный по заголовку Accept-Language.
+func language(ctx *gin.Context) string {
+	return i18n.Language(ctx.GetHeader("Accept-Language"))
+}
+
+// localize возвращает сообщение с кодом code на языке клиента.
+func localize(ctx *gin.Context, code string) string {
+	return i18n.Message(language(ctx), code)
+}
+
+// errorStatus возвращает HTTP-статус для ошибки сервиса по её виду.
+// Ошибка без вида считается внутренней.
+func errorStatus(err error) int {
+	var limitErr *errresponse.TransferLimitError
+
+	switch {
+	case errors.As(err, &limitErr):
+		return http.StatusForbidden
+	case errors.Is(err, errresponse.ErrValidation):
+		return http.StatusBadRequest
+	case errors.Is(err, errresponse.ErrUnauthorized):
+		return http.StatusUnauthorized
+	case errors.Is(err, errresponse.ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, errresponse.ErrConflict):
+		return http.StatusConflict
+	case errors.Is(err, errresponse.ErrInsufficientFunds):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// localizeError возвращает код ошибки и сообщение на языке клиента.
+// Текст ошибки без кода не показывается клиенту.
+func localizeError(ctx *gin.Context, err error) (code, text string) {
+	var localized errresponse.Localized
+	if errors.As(err, &localized) {
+		return localized.ErrorCode(), localized.Localize(language(ctx))
+	}
+
+	return "internal_error", localize(ctx, "internal_error")
+}
+
+// errorBody возвращает ответ ErrorResponse с кодом code и сообщением на языке клиента.
+func errorBody(ctx *gin.Context, code string) oapi.ErrorResponse {
+	text := localize(ctx, code)
+
+	return oapi.ErrorResponse{Error: &text, Code: &code}
+}
+
+// errorResponse отвечает на ошибку сервиса в формате ErrorResponse.
+func errorResponse(ctx *gin.Context, err error) {
+	code, text := localizeError(ctx, err)
+
+	ctx.JSON(errorStatus(err), oapi.ErrorResponse{Error: &text, Code: &code})
+}
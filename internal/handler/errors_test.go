@@ -0,0 +1,152 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	errresponse "github.com/MaksimovDenis/Avito_merch_shop/internal/err_response"
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/i18n"
+	"github.com/MaksimovDenis/Avito_merch_shop/pkg/protocol/oapi"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorResponse(t *testing.T) {
+	tests := []struct {
+		name            string
+		err             error
+		acceptLanguage  string
+		expectedStatus  int
+		expectedMessage string
+		expectedCode    string
+	}{
+		{
+			name:            "Validation",
+			err:             errresponse.Validation("cart_empty"),
+			expectedStatus:  http.StatusBadRequest,
+			expectedMessage: "корзина пуста",
+			expectedCode:    "cart_empty",
+		},
+		{
+			name:            "Unauthorized",
+			err:             errresponse.Unauthorized("invalid_credentials"),
+			expectedStatus:  http.StatusUnauthorized,
+			expectedMessage: "неверный логин или пароль",
+			expectedCode:    "invalid_credentials",
+		},
+		{
+			name:            "Not found",
+			err:             errresponse.ErrResponse(fmt.Errorf("%w", pgx.ErrNoRows), "books"),
+			expectedStatus:  http.StatusNotFound,
+			expectedMessage: "[books] не найден",
+			expectedCode:    "not_found",
+		},
+		{
+			name:            "Out of stock",
+			err:             errresponse.OutOfStock("cup"),
+			expectedStatus:  http.StatusConflict,
+			expectedMessage: "[cup] нет в наличии",
+			expectedCode:    "out_of_stock",
+		},
+		{
+			name:            "Unique violation",
+			err:             errresponse.ErrResponse(&pgconn.PgError{Code: "23505"}),
+			expectedStatus:  http.StatusConflict,
+			expectedMessage: "запись уже существует",
+			expectedCode:    "already_exists",
+		},
+		{
+			name: "Insufficient funds",
+			err: errresponse.ErrResponse(&pgconn.PgError{
+				Code:           "23514",
+				ConstraintName: "users_coins_check",
+			}),
+			expectedStatus:  http.StatusUnprocessableEntity,
+			expectedMessage: "недостаточно средств для покупки",
+			expectedCode:    "insufficient_funds",
+		},
+		{
+			name:            "Check violation",
+			err:             errresponse.ErrResponse(&pgconn.PgError{Code: "23514"}),
+			expectedStatus:  http.StatusBadRequest,
+			expectedMessage: "некорректные данные",
+			expectedCode:    "invalid_data",
+		},
+		{
+			name:            "Transfer limit",
+			err:             &errresponse.TransferLimitError{Code: errresponse.TransferLimitDaily, Limit: 500},
+			expectedStatus:  http.StatusForbidden,
+			expectedMessage: "превышен дневной лимит переводов 500 монет",
+			expectedCode:    errresponse.TransferLimitDaily,
+		},
+		{
+			name:            "Transfer limit in English",
+			err:             &errresponse.TransferLimitError{Code: errresponse.TransferLimitDaily, Limit: 500},
+			acceptLanguage:  "en-US,en;q=0.9,ru;q=0.8",
+			expectedStatus:  http.StatusForbidden,
+			expectedMessage: "daily transfer limit of 500 coins exceeded",
+			expectedCode:    errresponse.TransferLimitDaily,
+		},
+		{
+			name:            "Not found in English",
+			err:             errresponse.ErrResponse(pgx.ErrNoRows, i18n.EntityTransfer),
+			acceptLanguage:  "en",
+			expectedStatus:  http.StatusNotFound,
+			expectedMessage: "[transfer] not found",
+			expectedCode:    "not_found",
+		},
+		{
+			name:            "Unsupported language",
+			err:             errresponse.InsufficientFunds("insufficient_coins"),
+			acceptLanguage:  "de-DE",
+			expectedStatus:  http.StatusUnprocessableEntity,
+			expectedMessage: "недостаточно монет для перевода",
+			expectedCode:    "insufficient_coins",
+		},
+		{
+			name:            "Database failure",
+			err:             errresponse.ErrResponse(errors.New("connection refused")),
+			expectedStatus:  http.StatusInternalServerError,
+			expectedMessage: "ошибка при обновлении данных",
+			expectedCode:    "database_error",
+		},
+		{
+			name:            "Unknown error",
+			err:             errors.New("операция не сбалансирована"),
+			expectedStatus:  http.StatusInternalServerError,
+			expectedMessage: "внутренняя ошибка сервера",
+			expectedCode:    "internal_error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+
+			respRecord := httptest.NewRecorder()
+
+			testCtx, _ := gin.CreateTestContext(respRecord)
+
+			testCtx.Request = httptest.NewRequest("GET", "/", nil)
+			testCtx.Request.Header.Set("Accept-Language", tt.acceptLanguage)
+
+			errorResponse(testCtx, tt.err)
+
+			assert.Equal(t, tt.expectedStatus, respRecord.Code)
+
+			var res oapi.ErrorResponse
+			require.NoError(t, json.Unmarshal(respRecord.Body.Bytes(), &res))
+			require.NotNil(t, res.Error)
+			assert.Equal(t, tt.expectedMessage, *res.Error)
+			require.NotNil(t, res.Code)
+			assert.Equal(t, tt.expectedCode, *res.Code)
+		})
+	}
+}
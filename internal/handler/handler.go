@@ -6,6 +6,7 @@ import (
 	"github.com/MaksimovDenis/Avito_merch_shop/internal/metrics"
 	"github.com/MaksimovDenis/Avito_merch_shop/internal/service"
 	"github.com/MaksimovDenis/Avito_merch_shop/pkg/protocol/oapi"
+	"github.com/MaksimovDenis/Avito_merch_shop/pkg/protocol/oapiv2"
 	"github.com/MaksimovDenis/Avito_merch_shop/pkg/token"
 	"github.com/gin-gonic/gin"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -13,15 +14,18 @@ import (
 )
 
 const (
-	FileUploadBufferSize       = 512e+6 // 512MB for now
+	FileUploadBufferSize       = 512e+6                      // 512MB for now
+	MaxRequestBodySize         = FileUploadBufferSize + 1e+6 // файл и служебные части multipart-запроса
 	ServerShutdownDefaultDelay = 5 * time.Second
 )
 
 type Handler struct {
-	appService service.Service
-	tokenMaker *token.JWTMaker
-	log        zerolog.Logger
-	metrics    *metrics.Metrics
+	appService        service.Service
+	tokenMaker        *token.JWTMaker
+	log               zerolog.Logger
+	metrics           *metrics.Metrics
+	validateResponses bool
+	docsEnabled       bool
 }
 
 func NewHandler(
@@ -37,21 +41,64 @@ func NewHandler(
 	}
 }
 
-func (hdl *Handler) InitRoutes() *gin.Engine {
+// EnableResponseValidation включает проверку ответов по схеме API.
+// Используется в тестах, чтобы ответы сервера не расходились со схемой.
+func (hdl *Handler) EnableResponseValidation() {
+	hdl.validateResponses = true
+}
+
+// EnableDocs включает страницу документации API /docs.
+func (hdl *Handler) EnableDocs() {
+	hdl.docsEnabled = true
+}
+
+func (hdl *Handler) InitRoutes() (*gin.Engine, error) {
 	router := gin.New()
 
 	router.MaxMultipartMemory = FileUploadBufferSize
 
 	tokenMaker := hdl.tokenMaker
 
+	v1Router, err := newSpecRouter(oapi.GetSwagger)
+	if err != nil {
+		return nil, err
+	}
+
+	v2Router, err := newSpecRouter(oapiv2.GetSwagger)
+	if err != nil {
+		return nil, err
+	}
+
 	router.GET("/metrics", gin.WrapH(promhttp.Handler()))
+
+	if err := registerSpecRoutes(router, hdl.docsEnabled); err != nil {
+		return nil, err
+	}
+
 	router.Use(hdl.metrics.HTTPMetrics())
+	router.Use(GetDeprecationMiddlewareFunc(v1Router))
+	router.Use(GetValidationMiddlewareFunc(specRouters{v2Router, v1Router}, tokenMaker, hdl.validateResponses))
 	oapi.RegisterHandlersWithOptions(router, hdl, oapi.GinServerOptions{
 		BaseURL: "/",
 		Middlewares: []oapi.MiddlewareFunc{
 			GetAuthMiddlewareFunc(tokenMaker),
+			GetAdminMiddlewareFunc(),
+		},
+		ErrorHandler: paramsErrorHandler,
+	})
+	oapiv2.RegisterHandlersWithOptions(router, hdl, oapiv2.GinServerOptions{
+		BaseURL: "/",
+		Middlewares: []oapiv2.MiddlewareFunc{
+			GetAuthMiddlewareFunc(tokenMaker),
 		},
+		ErrorHandler: paramsErrorHandler,
 	})
 
-	return router
+	return router, nil
+}
+
+// paramsErrorHandler отвечает на ошибку разбора параметров запроса в обработчиках,
+// сгенерированных по схеме API.
+func paramsErrorHandler(ctx *gin.Context, _ error, statusCode int) {
+	ctx.JSON(statusCode, errorBody(ctx, "bad_request"))
 }
@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/models"
+	"github.com/MaksimovDenis/Avito_merch_shop/pkg/protocol/oapi"
+	"github.com/gin-gonic/gin"
+)
+
+func (hdl *Handler) PostApiAdminCoinsIssuances(ctx *gin.Context, params oapi.PostApiAdminCoinsIssuancesParams) {
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		hdl.log.Error().Err(err).Msg("failed to read issuance file")
+		ctx.JSON(http.StatusBadRequest, errorBody(ctx, "bad_request"))
+
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		hdl.log.Error().Err(err).Msg("failed to open issuance file")
+		errorResponse(ctx, err)
+
+		return
+	}
+	defer file.Close()
+
+	dryRun := params.DryRun != nil && *params.DryRun
+
+	report, err := hdl.appService.Issuance.IssueCoins(ctx, adminName(ctx), file, dryRun)
+	if err != nil {
+		errorResponse(ctx, err)
+		return
+	}
+
+	if report.Errors > 0 {
+		ctx.JSON(http.StatusBadRequest, toOapiIssuanceReport(ctx, report))
+		return
+	}
+
+	if report.Applied {
+		hdl.log.Info().Msgf("admin %v issued coins to %v users", adminName(ctx), report.Total)
+	}
+
+	ctx.JSON(http.StatusOK, toOapiIssuanceReport(ctx, report))
+}
+
+func toOapiIssuanceReport(ctx *gin.Context, report models.IssuanceReport) oapi.IssuanceReport {
+	rows := make([]oapi.IssuanceRow, 0, len(report.Rows))
+
+	for _, row := range report.Rows {
+		issuanceRow := oapi.IssuanceRow{
+			Line:     row.Line,
+			Username: row.Username,
+			Amount:   row.Amount,
+			Reason:   row.Reason,
+		}
+
+		if row.Error != nil {
+			code, text := localizeError(ctx, row.Error)
+			issuanceRow.Error = &text
+			issuanceRow.ErrorCode = &code
+		}
+
+		rows = append(rows, issuanceRow)
+	}
+
+	return oapi.IssuanceReport{
+		DryRun:  report.DryRun,
+		Applied: report.Applied,
+		Total:   report.Total,
+		Errors:  report.Errors,
+		Rows:    rows,
+	}
+}
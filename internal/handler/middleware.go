@@ -3,22 +3,44 @@ package handler
 import (
 	"fmt"
 	"net/http"
+	"slices"
 	"strings"
 
+	"github.com/MaksimovDenis/Avito_merch_shop/pkg/protocol/oapi"
 	"github.com/MaksimovDenis/Avito_merch_shop/pkg/token"
+	"github.com/getkin/kin-openapi/routers"
 	"github.com/gin-gonic/gin"
 )
 
+const (
+	// v1DeprecatedAt - дата, с которой первая версия API считается устаревшей,
+	// в формате заголовка Deprecation (RFC 9745).
+	v1DeprecatedAt = "@1792195200"
+	// v1SuccessorLink указывает клиентам первой версии API на схему второй версии.
+	v1SuccessorLink = `</v2/openapi.yaml>; rel="successor-version"`
+)
+
+// adminPathPrefix - префикс административных эндпоинтов. Они закрыты для
+// обычных пользователей, даже если в схеме у операции забыт скоуп admin.
+const adminPathPrefix = "/api/admin/"
+
+// publicPaths - эндпоинты, доступные без JWT-токена.
+var publicPaths = map[string]struct{}{
+	"/api/auth":     {},
+	"/api/products": {},
+	"/api/v2/auth":  {},
+}
+
 func GetAuthMiddlewareFunc(tokenMaker *token.JWTMaker) func(ctx *gin.Context) {
 	return func(ctx *gin.Context) {
-		if ctx.Request.URL.Path == "/api/auth" {
+		if _, ok := publicPaths[ctx.Request.URL.Path]; ok {
 			ctx.Next()
 			return
 		}
 
 		claims, err := verifyClaimsFromAuthHeader(ctx, *tokenMaker)
 		if err != nil {
-			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorBody(ctx, "unauthorized"))
 			return
 		}
 
@@ -27,6 +49,53 @@ func GetAuthMiddlewareFunc(tokenMaker *token.JWTMaker) func(ctx *gin.Context) {
 	}
 }
 
+// GetAdminMiddlewareFunc пропускает к операциям, помеченным в схеме скоупом admin,
+// и к эндпоинтам с префиксом /api/admin/ только пользователей с ролью администратора.
+func GetAdminMiddlewareFunc() func(ctx *gin.Context) {
+	return func(ctx *gin.Context) {
+		if !requiresAdmin(ctx) {
+			ctx.Next()
+			return
+		}
+
+		claims, ok := ctx.Get("user")
+		if !ok {
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorBody(ctx, "unauthorized"))
+			return
+		}
+
+		if claims.(*token.UserClaims).Role != token.RoleAdmin {
+			ctx.AbortWithStatusJSON(http.StatusForbidden, errorBody(ctx, "forbidden"))
+			return
+		}
+
+		ctx.Next()
+	}
+}
+
+func requiresAdmin(ctx *gin.Context) bool {
+	if strings.HasPrefix(ctx.Request.URL.Path, adminPathPrefix) {
+		return true
+	}
+
+	scopes, ok := ctx.Get(oapi.BearerAuthScopes)
+
+	return ok && slices.Contains(scopes.([]string), token.RoleAdmin)
+}
+
+// GetDeprecationMiddlewareFunc помечает ответы на запросы к операциям первой
+// версии API заголовками Deprecation и Link. Операции по-прежнему выполняются.
+func GetDeprecationMiddlewareFunc(v1Router routers.Router) func(ctx *gin.Context) {
+	return func(ctx *gin.Context) {
+		if _, _, err := v1Router.FindRoute(ctx.Request); err == nil {
+			ctx.Header("Deprecation", v1DeprecatedAt)
+			ctx.Header("Link", v1SuccessorLink)
+		}
+
+		ctx.Next()
+	}
+}
+
 func verifyClaimsFromAuthHeader(ctx *gin.Context, tokenMaker token.JWTMaker) (*token.UserClaims, error) {
 	authHeader := ctx.Request.Header.Get("Authorization")
 	if authHeader == "" {
@@ -2,14 +2,17 @@ package handler
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"net/http"
 	"net/http/httptest"
 
+	"github.com/MaksimovDenis/Avito_merch_shop/pkg/protocol/oapi"
 	"github.com/MaksimovDenis/Avito_merch_shop/pkg/token"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestVerifyClaimsFromAuthHeader(t *testing.T) {
@@ -80,19 +83,19 @@ func TestGetAuthMiddlewareFunc(t *testing.T) {
 			name:           "No Authorization Header",
 			authHeader:     "",
 			expectedStatus: http.StatusUnauthorized,
-			expectedError:  "autorizatoin header is missing",
+			expectedError:  "Неавторизован",
 		},
 		{
 			name:           "Invalid Authorization Header Format",
 			authHeader:     "InvalidToken",
 			expectedStatus: http.StatusUnauthorized,
-			expectedError:  "invalid autorization header",
+			expectedError:  "Неавторизован",
 		},
 		{
 			name:           "Invalid Bearer Prefix",
 			authHeader:     "Token 123",
 			expectedStatus: http.StatusUnauthorized,
-			expectedError:  "invalid autorization header",
+			expectedError:  "Неавторизован",
 		},
 	}
 
@@ -113,7 +116,8 @@ func TestGetAuthMiddlewareFunc(t *testing.T) {
 			assert.Equal(t, tt.expectedStatus, responseRecord.Code)
 
 			if tt.expectedError != "" {
-				assert.JSONEq(t, fmt.Sprintf(`{"error": "%s"}`, tt.expectedError), responseRecord.Body.String())
+				assert.JSONEq(t, fmt.Sprintf(`{"error": "%s", "code": "unauthorized"}`, tt.expectedError),
+					responseRecord.Body.String())
 			} else {
 				user, exists := testCtx.Get(tt.expectedUserKey)
 				if !assert.True(t, exists) {
@@ -125,3 +129,89 @@ func TestGetAuthMiddlewareFunc(t *testing.T) {
 		})
 	}
 }
+
+func TestGetAdminMiddlewareFunc(t *testing.T) {
+	tests := []struct {
+		name           string
+		path           string
+		scopes         []string
+		claims         *token.UserClaims
+		expectedStatus int
+		expectedAbort  bool
+	}{
+		{
+			name:           "Operation without admin scope",
+			path:           "/api/buy/cup",
+			scopes:         []string{},
+			claims:         &token.UserClaims{UserName: "user", Role: token.RoleUser},
+			expectedStatus: http.StatusOK,
+			expectedAbort:  false,
+		},
+		{
+			name:           "Admin scope, regular user",
+			path:           "/api/admin/products",
+			scopes:         []string{token.RoleAdmin},
+			claims:         &token.UserClaims{UserName: "user", Role: token.RoleUser},
+			expectedStatus: http.StatusForbidden,
+			expectedAbort:  true,
+		},
+		{
+			name:           "Admin scope, admin user",
+			path:           "/api/admin/products",
+			scopes:         []string{token.RoleAdmin},
+			claims:         &token.UserClaims{UserName: "admin", Role: token.RoleAdmin},
+			expectedStatus: http.StatusOK,
+			expectedAbort:  false,
+		},
+		{
+			name:           "Admin path without admin scope, regular user",
+			path:           "/api/admin/products",
+			scopes:         []string{},
+			claims:         &token.UserClaims{UserName: "user", Role: token.RoleUser},
+			expectedStatus: http.StatusForbidden,
+			expectedAbort:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+
+			responseRecord := httptest.NewRecorder()
+
+			testCtx, _ := gin.CreateTestContext(responseRecord)
+
+			testCtx.Request = httptest.NewRequest("POST", tt.path, nil)
+			testCtx.Set(oapi.BearerAuthScopes, tt.scopes)
+			testCtx.Set("user", tt.claims)
+
+			middleware := GetAdminMiddlewareFunc()
+			middleware(testCtx)
+
+			assert.Equal(t, tt.expectedStatus, responseRecord.Code)
+			assert.Equal(t, tt.expectedAbort, testCtx.IsAborted())
+		})
+	}
+}
+
+func TestAdminOperationsHaveAdminScope(t *testing.T) {
+	swagger, err := oapi.GetSwagger()
+	require.NoError(t, err)
+
+	for path, item := range swagger.Paths.Map() {
+		if !strings.HasPrefix(path, adminPathPrefix) {
+			continue
+		}
+
+		for method, operation := range item.Operations() {
+			require.NotNil(t, operation.Security, "%s %s", method, path)
+
+			var scopes []string
+			for _, requirement := range *operation.Security {
+				scopes = append(scopes, requirement["BearerAuth"]...)
+			}
+
+			assert.Contains(t, scopes, token.RoleAdmin, "%s %s", method, path)
+		}
+	}
+}
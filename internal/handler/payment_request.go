@@ -0,0 +1,143 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/models"
+	"github.com/MaksimovDenis/Avito_merch_shop/pkg/protocol/oapi"
+	"github.com/MaksimovDenis/Avito_merch_shop/pkg/token"
+	"github.com/gin-gonic/gin"
+)
+
+func (hdl *Handler) PostApiPaymentRequests(ctx *gin.Context) {
+	var createReq oapi.PaymentRequestCreateRequest
+
+	if err := ctx.BindJSON(&createReq); err != nil {
+		hdl.log.Error().Err(err).Msg("failed to parse request body")
+		ctx.JSON(http.StatusBadRequest, errorBody(ctx, "bad_request"))
+
+		return
+	}
+
+	claims, ok := ctx.Get("user")
+	if !ok {
+		hdl.log.Error().Msg("user claims not found in context")
+		ctx.JSON(http.StatusUnauthorized, errorBody(ctx, "unauthorized"))
+
+		return
+	}
+
+	userId := claims.(*token.UserClaims).ID
+
+	var reason string
+	if createReq.Reason != nil {
+		reason = *createReq.Reason
+	}
+
+	requestId, err := hdl.appService.PaymentRequest.CreatePaymentRequest(ctx, int(userId), createReq.FromUser,
+		createReq.Amount, reason)
+	if err != nil {
+		errorResponse(ctx, err)
+		return
+	}
+
+	hdl.log.Info().Msgf("userId %v requested %v coins from user %v (request %v)", userId, createReq.Amount,
+		createReq.FromUser, requestId)
+
+	ctx.JSON(http.StatusOK, gin.H{"message": localize(ctx, "payment_request_sent")})
+}
+
+func (hdl *Handler) GetApiPaymentRequests(ctx *gin.Context, params oapi.GetApiPaymentRequestsParams) {
+	claims, ok := ctx.Get("user")
+	if !ok {
+		hdl.log.Error().Msg("user claims not found in context")
+		ctx.JSON(http.StatusUnauthorized, errorBody(ctx, "unauthorized"))
+
+		return
+	}
+
+	userId := claims.(*token.UserClaims).ID
+
+	var filter models.PaymentRequestsFilter
+
+	if params.Status != nil {
+		filter.Status = string(*params.Status)
+	}
+
+	if params.Limit != nil {
+		filter.Limit = *params.Limit
+	}
+
+	if params.Offset != nil {
+		filter.Offset = *params.Offset
+	}
+
+	requests, err := hdl.appService.PaymentRequest.IncomingPaymentRequests(ctx, int(userId), filter)
+	if err != nil {
+		errorResponse(ctx, err)
+		return
+	}
+
+	items := make([]oapi.PaymentRequest, 0, len(requests))
+
+	for _, request := range requests {
+		id := request.Id
+		fromUser := request.Requester
+		amount := request.Amount
+		status := oapi.PaymentRequestStatus(request.Status)
+		createdAt := request.CreatedAt
+
+		items = append(items, oapi.PaymentRequest{
+			Id:        &id,
+			FromUser:  &fromUser,
+			Amount:    &amount,
+			Reason:    request.Reason,
+			Status:    &status,
+			CreatedAt: &createdAt,
+		})
+	}
+
+	ctx.JSON(http.StatusOK, oapi.PaymentRequestsResponse{Items: &items})
+}
+
+func (hdl *Handler) PostApiPaymentRequestsIdApprove(ctx *gin.Context, requestId int) {
+	claims, ok := ctx.Get("user")
+	if !ok {
+		hdl.log.Error().Msg("user claims not found in context")
+		ctx.JSON(http.StatusUnauthorized, errorBody(ctx, "unauthorized"))
+
+		return
+	}
+
+	userId := claims.(*token.UserClaims).ID
+
+	if err := hdl.appService.PaymentRequest.ApprovePaymentRequest(ctx, int(userId), requestId); err != nil {
+		errorResponse(ctx, err)
+		return
+	}
+
+	hdl.log.Info().Msgf("userId %v approved payment request %v", userId, requestId)
+
+	ctx.JSON(http.StatusOK, gin.H{"message": localize(ctx, "payment_request_approved")})
+}
+
+func (hdl *Handler) PostApiPaymentRequestsIdReject(ctx *gin.Context, requestId int) {
+	claims, ok := ctx.Get("user")
+	if !ok {
+		hdl.log.Error().Msg("user claims not found in context")
+		ctx.JSON(http.StatusUnauthorized, errorBody(ctx, "unauthorized"))
+
+		return
+	}
+
+	userId := claims.(*token.UserClaims).ID
+
+	if err := hdl.appService.PaymentRequest.RejectPaymentRequest(ctx, int(userId), requestId); err != nil {
+		errorResponse(ctx, err)
+		return
+	}
+
+	hdl.log.Info().Msgf("userId %v rejected payment request %v", userId, requestId)
+
+	ctx.JSON(http.StatusOK, gin.H{"message": localize(ctx, "payment_request_rejected")})
+}
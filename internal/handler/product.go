@@ -0,0 +1,104 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/models"
+	"github.com/MaksimovDenis/Avito_merch_shop/pkg/protocol/oapi"
+	"github.com/MaksimovDenis/Avito_merch_shop/pkg/token"
+	"github.com/gin-gonic/gin"
+)
+
+func (hdl *Handler) PostApiAdminProducts(ctx *gin.Context) {
+	var createReq oapi.ProductCreateRequest
+
+	if err := ctx.BindJSON(&createReq); err != nil {
+		hdl.log.Error().Err(err).Msg("failed to parse request body")
+		ctx.JSON(http.StatusBadRequest, errorBody(ctx, "bad_request"))
+
+		return
+	}
+
+	product := models.Product{
+		Name:      createReq.Name,
+		Price:     createReq.Price,
+		Available: true,
+		Stock:     createReq.Stock,
+	}
+
+	if createReq.Available != nil {
+		product.Available = *createReq.Available
+	}
+
+	created, err := hdl.appService.Product.CreateProduct(ctx, product)
+	if err != nil {
+		errorResponse(ctx, err)
+		return
+	}
+
+	hdl.log.Info().Msgf("admin %v created product %v", adminName(ctx), created.Name)
+
+	ctx.JSON(http.StatusOK, toOapiProduct(created))
+}
+
+func (hdl *Handler) PatchApiAdminProductsName(ctx *gin.Context, name string) {
+	var updateReq oapi.ProductUpdateRequest
+
+	if err := ctx.BindJSON(&updateReq); err != nil {
+		hdl.log.Error().Err(err).Msg("failed to parse request body")
+		ctx.JSON(http.StatusBadRequest, errorBody(ctx, "bad_request"))
+
+		return
+	}
+
+	update := models.ProductUpdate{
+		Name:      updateReq.Name,
+		Price:     updateReq.Price,
+		Available: updateReq.Available,
+		Stock:     updateReq.Stock,
+	}
+
+	if updateReq.UnlimitedStock != nil {
+		update.UnlimitedStock = *updateReq.UnlimitedStock
+	}
+
+	updated, err := hdl.appService.Product.UpdateProduct(ctx, name, update)
+	if err != nil {
+		errorResponse(ctx, err)
+		return
+	}
+
+	hdl.log.Info().Msgf("admin %v updated product %v", adminName(ctx), name)
+
+	ctx.JSON(http.StatusOK, toOapiProduct(updated))
+}
+
+func (hdl *Handler) DeleteApiAdminProductsName(ctx *gin.Context, name string) {
+	retired, err := hdl.appService.Product.RetireProduct(ctx, name)
+	if err != nil {
+		errorResponse(ctx, err)
+		return
+	}
+
+	hdl.log.Info().Msgf("admin %v retired product %v", adminName(ctx), name)
+
+	ctx.JSON(http.StatusOK, toOapiProduct(retired))
+}
+
+func toOapiProduct(product models.Product) oapi.Product {
+	return oapi.Product{
+		Name:      &product.Name,
+		Price:     &product.Price,
+		Available: &product.Available,
+		Stock:     product.Stock,
+	}
+}
+
+func adminName(ctx *gin.Context) string {
+	claims, ok := ctx.Get("user")
+	if !ok {
+		return ""
+	}
+
+	return claims.(*token.UserClaims).UserName
+}
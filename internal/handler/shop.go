@@ -3,38 +3,129 @@ package handler
 import (
 	"net/http"
 
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/models"
 	"github.com/MaksimovDenis/Avito_merch_shop/pkg/protocol/oapi"
 	"github.com/MaksimovDenis/Avito_merch_shop/pkg/token"
 	"github.com/gin-gonic/gin"
 )
 
-func (hdl *Handler) GetApiBuyItem(ctx *gin.Context, productName string) {
+func (hdl *Handler) GetApiBuyItem(ctx *gin.Context, productName string, params oapi.GetApiBuyItemParams) {
 	claims, ok := ctx.Get("user")
 	if !ok {
 		hdl.log.Error().Msg("user claims not found in context")
-		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Неавторизован"})
+		ctx.JSON(http.StatusUnauthorized, errorBody(ctx, "unauthorized"))
 
 		return
 	}
 
 	userId := claims.(*token.UserClaims).ID
 
-	if err := hdl.appService.Shop.BuyItem(ctx, int(userId), productName); err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	quantity := 1
+	if params.Quantity != nil {
+		quantity = *params.Quantity
+	}
+
+	var idempotencyKey string
+	if params.IdempotencyKey != nil {
+		idempotencyKey = *params.IdempotencyKey
+	}
+
+	_, err := hdl.appService.Shop.BuyItem(ctx, int(userId), productName, quantity, idempotencyKey)
+	if err != nil {
+		errorResponse(ctx, err)
+		return
+	}
+
+	hdl.log.Info().Msgf("userId %v bought %v x%v", userId, productName, quantity)
+
+	ctx.JSON(http.StatusOK, gin.H{"message": localize(ctx, "item_purchased")})
+}
+
+func (hdl *Handler) GetApiPurchases(ctx *gin.Context, params oapi.GetApiPurchasesParams) {
+	claims, ok := ctx.Get("user")
+	if !ok {
+		hdl.log.Error().Msg("user claims not found in context")
+		ctx.JSON(http.StatusUnauthorized, errorBody(ctx, "unauthorized"))
+
+		return
+	}
+
+	userId := claims.(*token.UserClaims).ID
+
+	var filter models.PurchasesFilter
+
+	if params.Limit != nil {
+		filter.Limit = *params.Limit
+	}
+
+	if params.Offset != nil {
+		filter.Offset = *params.Offset
+	}
+
+	purchases, total, spent, err := hdl.appService.Shop.Purchases(ctx, int(userId), filter)
+	if err != nil {
+		errorResponse(ctx, err)
+		return
+	}
+
+	items := make([]oapi.Purchase, 0, len(purchases))
+
+	for _, purchase := range purchases {
+		id := purchase.Id
+		name := purchase.Name
+		quantity := purchase.Quantity
+		unitPrice := purchase.UnitPrice
+		totalPrice := purchase.TotalPrice
+		status := oapi.PurchaseStatus(purchase.Status)
+		purchasedAt := purchase.PurchasedAt
+
+		items = append(items, oapi.Purchase{
+			Id:          &id,
+			Name:        &name,
+			Quantity:    &quantity,
+			UnitPrice:   &unitPrice,
+			TotalPrice:  &totalPrice,
+			Status:      &status,
+			PurchasedAt: &purchasedAt,
+		})
+	}
+
+	res := oapi.PurchasesResponse{
+		Items: &items,
+		Total: &total,
+		Spent: &spent,
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+func (hdl *Handler) PostApiPurchasesIdRefund(ctx *gin.Context, purchaseId int) {
+	claims, ok := ctx.Get("user")
+	if !ok {
+		hdl.log.Error().Msg("user claims not found in context")
+		ctx.JSON(http.StatusUnauthorized, errorBody(ctx, "unauthorized"))
+
+		return
+	}
+
+	userId := claims.(*token.UserClaims).ID
+
+	if err := hdl.appService.Shop.RefundPurchase(ctx, int(userId), purchaseId); err != nil {
+		errorResponse(ctx, err)
 		return
 	}
 
-	hdl.log.Info().Msgf("userId %v bought %v", userId, productName)
+	hdl.log.Info().Msgf("userId %v refunded purchase %v", userId, purchaseId)
 
-	ctx.JSON(http.StatusOK, gin.H{"message": "Товар приобретён"})
+	ctx.JSON(http.StatusOK, gin.H{"message": localize(ctx, "purchase_refunded")})
 }
 
-func (hdl *Handler) PostApiSendCoin(ctx *gin.Context) {
+func (hdl *Handler) PostApiSendCoin(ctx *gin.Context, params oapi.PostApiSendCoinParams) {
 	var sendCoinsReq oapi.SendCoinRequest
 
 	if err := ctx.BindJSON(&sendCoinsReq); err != nil {
 		hdl.log.Error().Err(err).Msg("failed to parse request body")
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Неверный запрос"})
+		ctx.JSON(http.StatusBadRequest, errorBody(ctx, "bad_request"))
 
 		return
 	}
@@ -42,28 +133,151 @@ func (hdl *Handler) PostApiSendCoin(ctx *gin.Context) {
 	claims, ok := ctx.Get("user")
 	if !ok {
 		hdl.log.Error().Msg("user claims not found in context")
-		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Неавторизован"})
+		ctx.JSON(http.StatusUnauthorized, errorBody(ctx, "unauthorized"))
 
 		return
 	}
 
 	sender := claims.(*token.UserClaims).UserName
 
-	if err := hdl.appService.Shop.SendCoins(ctx, sender, sendCoinsReq.ToUser, sendCoinsReq.Amount); err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	var idempotencyKey string
+	if params.IdempotencyKey != nil {
+		idempotencyKey = *params.IdempotencyKey
+	}
+
+	var message string
+	if sendCoinsReq.Message != nil {
+		message = *sendCoinsReq.Message
+	}
+
+	if sendCoinsReq.RequireAcceptance != nil && *sendCoinsReq.RequireAcceptance {
+		_, err := hdl.appService.Shop.SendCoinsPending(ctx, sender, sendCoinsReq.ToUser, sendCoinsReq.Amount, message,
+			idempotencyKey)
+		if err != nil {
+			errorResponse(ctx, err)
+			return
+		}
+
+		hdl.log.Info().Msgf("user %v sent %v coins to user %v pending acceptance", sender, sendCoinsReq.Amount,
+			sendCoinsReq.ToUser)
+
+		ctx.JSON(http.StatusOK, gin.H{"message": localize(ctx, "transfer_pending")})
+
+		return
+	}
+
+	_, err := hdl.appService.Shop.SendCoins(ctx, sender, sendCoinsReq.ToUser, sendCoinsReq.Amount, message,
+		idempotencyKey)
+	if err != nil {
+		errorResponse(ctx, err)
 		return
 	}
 
 	hdl.log.Info().Msgf("user %v sent %v coins to user %v", sender, sendCoinsReq.Amount, sendCoinsReq.ToUser)
 
-	ctx.JSON(http.StatusOK, gin.H{"message": "Перевод выполнен"})
+	ctx.JSON(http.StatusOK, gin.H{"message": localize(ctx, "transfer_completed")})
+}
+
+func (hdl *Handler) PostApiTransactionsIdAccept(ctx *gin.Context, transactionId int) {
+	claims, ok := ctx.Get("user")
+	if !ok {
+		hdl.log.Error().Msg("user claims not found in context")
+		ctx.JSON(http.StatusUnauthorized, errorBody(ctx, "unauthorized"))
+
+		return
+	}
+
+	userId := claims.(*token.UserClaims).ID
+
+	if err := hdl.appService.Shop.AcceptTransfer(ctx, int(userId), transactionId); err != nil {
+		errorResponse(ctx, err)
+		return
+	}
+
+	hdl.log.Info().Msgf("userId %v accepted transfer %v", userId, transactionId)
+
+	ctx.JSON(http.StatusOK, gin.H{"message": localize(ctx, "transfer_accepted")})
+}
+
+func (hdl *Handler) PostApiTransactionsIdDecline(ctx *gin.Context, transactionId int) {
+	claims, ok := ctx.Get("user")
+	if !ok {
+		hdl.log.Error().Msg("user claims not found in context")
+		ctx.JSON(http.StatusUnauthorized, errorBody(ctx, "unauthorized"))
+
+		return
+	}
+
+	userId := claims.(*token.UserClaims).ID
+
+	if err := hdl.appService.Shop.DeclineTransfer(ctx, int(userId), transactionId); err != nil {
+		errorResponse(ctx, err)
+		return
+	}
+
+	hdl.log.Info().Msgf("userId %v declined transfer %v", userId, transactionId)
+
+	ctx.JSON(http.StatusOK, gin.H{"message": localize(ctx, "transfer_declined")})
+}
+
+func (hdl *Handler) PostApiSendCoinBatch(ctx *gin.Context, params oapi.PostApiSendCoinBatchParams) {
+	var batchReq oapi.BatchSendCoinRequest
+
+	if err := ctx.BindJSON(&batchReq); err != nil {
+		hdl.log.Error().Err(err).Msg("failed to parse request body")
+		ctx.JSON(http.StatusBadRequest, errorBody(ctx, "bad_request"))
+
+		return
+	}
+
+	claims, ok := ctx.Get("user")
+	if !ok {
+		hdl.log.Error().Msg("user claims not found in context")
+		ctx.JSON(http.StatusUnauthorized, errorBody(ctx, "unauthorized"))
+
+		return
+	}
+
+	sender := claims.(*token.UserClaims).UserName
+
+	var idempotencyKey string
+	if params.IdempotencyKey != nil {
+		idempotencyKey = *params.IdempotencyKey
+	}
+
+	var message string
+	if batchReq.Message != nil {
+		message = *batchReq.Message
+	}
+
+	recipients := make([]models.TransferRecipient, 0, len(batchReq.Recipients))
+
+	for _, recipient := range batchReq.Recipients {
+		recipients = append(recipients, models.TransferRecipient{
+			ToUser: recipient.ToUser,
+			Amount: recipient.Amount,
+		})
+	}
+
+	transactionIds, err := hdl.appService.Shop.SendCoinsBatch(ctx, sender, recipients, message, idempotencyKey)
+	if err != nil {
+		errorResponse(ctx, err)
+		return
+	}
+
+	hdl.log.Info().Msgf("user %v sent coins to %v users", sender, len(recipients))
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message":        localize(ctx, "transfer_completed"),
+		"transactionIds": transactionIds,
+	})
 }
 
 func (hdl *Handler) GetApiInfo(ctx *gin.Context) {
 	claims, ok := ctx.Get("user")
 	if !ok {
 		hdl.log.Error().Msg("user claims not found in context")
-		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Неавторизован"})
+		ctx.JSON(http.StatusUnauthorized, errorBody(ctx, "unauthorized"))
 
 		return
 	}
@@ -72,14 +286,28 @@ func (hdl *Handler) GetApiInfo(ctx *gin.Context) {
 
 	coins, items, sentCoins, receivedCoins, err := hdl.appService.Shop.Info(ctx, username)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		errorResponse(ctx, err)
+		return
+	}
+
+	expiringLots, err := hdl.appService.Shop.ExpiringCoins(ctx, int(claims.(*token.UserClaims).ID))
+	if err != nil {
+		errorResponse(ctx, err)
 		return
 	}
 
-	var inventory []struct {
+	expiresSoon := make([]oapi.ExpiringCoins, 0, len(expiringLots))
+	for _, lot := range expiringLots {
+		expiresSoon = append(expiresSoon, oapi.ExpiringCoins{
+			Amount:    lot.Remaining,
+			ExpiresAt: lot.ExpiresAt,
+		})
+	}
+
+	inventory := make([]struct {
 		Quantity *int    `json:"quantity,omitempty"`
 		Type     *string `json:"type,omitempty"`
-	}
+	}, 0, len(items))
 
 	if len(items) != 0 {
 		for _, item := range items {
@@ -95,39 +323,47 @@ func (hdl *Handler) GetApiInfo(ctx *gin.Context) {
 		}
 	}
 
-	var received []struct {
-		Amount   *int    `json:"amount,omitempty"`
-		FromUser *string `json:"fromUser,omitempty"`
-	}
+	received := make([]struct {
+		Amount   *int      `json:"amount,omitempty"`
+		FromUser *string   `json:"fromUser,omitempty"`
+		Messages *[]string `json:"messages,omitempty"`
+	}, 0, len(receivedCoins))
 
 	if len(receivedCoins) != 0 {
 		for _, rc := range receivedCoins {
 			amount := rc.Amount
 			fromUser := rc.FromUser
+			messages := rc.Messages
 			received = append(received, struct {
-				Amount   *int    `json:"amount,omitempty"`
-				FromUser *string `json:"fromUser,omitempty"`
+				Amount   *int      `json:"amount,omitempty"`
+				FromUser *string   `json:"fromUser,omitempty"`
+				Messages *[]string `json:"messages,omitempty"`
 			}{
 				Amount:   &amount,
 				FromUser: &fromUser,
+				Messages: &messages,
 			})
 		}
 	}
 
-	var sent []struct {
-		Amount *int    `json:"amount,omitempty"`
-		ToUser *string `json:"toUser,omitempty"`
-	}
+	sent := make([]struct {
+		Amount *int                  `json:"amount,omitempty"`
+		Status *oapi.SentCoinsStatus `json:"status,omitempty"`
+		ToUser *string               `json:"toUser,omitempty"`
+	}, 0, len(sentCoins))
 
 	if len(sentCoins) != 0 {
 		for _, sc := range sentCoins {
 			amount := sc.Amount
+			status := oapi.SentCoinsStatus(sc.Status)
 			toUser := sc.ToUser
 			sent = append(sent, struct {
-				Amount *int    `json:"amount,omitempty"`
-				ToUser *string `json:"toUser,omitempty"`
+				Amount *int                  `json:"amount,omitempty"`
+				Status *oapi.SentCoinsStatus `json:"status,omitempty"`
+				ToUser *string               `json:"toUser,omitempty"`
 			}{
 				Amount: &amount,
+				Status: &status,
 				ToUser: &toUser,
 			})
 		}
@@ -138,20 +374,144 @@ func (hdl *Handler) GetApiInfo(ctx *gin.Context) {
 		Inventory: &inventory,
 		CoinHistory: &struct {
 			Received *[]struct {
-				Amount   *int    `json:"amount,omitempty"`
-				FromUser *string `json:"fromUser,omitempty"`
+				Amount   *int      `json:"amount,omitempty"`
+				FromUser *string   `json:"fromUser,omitempty"`
+				Messages *[]string `json:"messages,omitempty"`
 			} `json:"received,omitempty"`
 			Sent *[]struct {
-				Amount *int    `json:"amount,omitempty"`
-				ToUser *string `json:"toUser,omitempty"`
+				Amount *int                  `json:"amount,omitempty"`
+				Status *oapi.SentCoinsStatus `json:"status,omitempty"`
+				ToUser *string               `json:"toUser,omitempty"`
 			} `json:"sent,omitempty"`
 		}{
 			Received: &received,
 			Sent:     &sent,
 		},
+		ExpiresSoon: &expiresSoon,
 	}
 
 	hdl.log.Info().Msgf("user %v get info", username)
 
 	ctx.JSON(http.StatusOK, res)
 }
+
+func (hdl *Handler) GetApiTransactions(ctx *gin.Context, params oapi.GetApiTransactionsParams) {
+	claims, ok := ctx.Get("user")
+	if !ok {
+		hdl.log.Error().Msg("user claims not found in context")
+		ctx.JSON(http.StatusUnauthorized, errorBody(ctx, "unauthorized"))
+
+		return
+	}
+
+	userId := claims.(*token.UserClaims).ID
+
+	filter := models.TransactionsFilter{
+		From: params.From,
+		To:   params.To,
+	}
+
+	if params.Limit != nil {
+		filter.Limit = *params.Limit
+	}
+
+	if params.Counterparty != nil {
+		filter.Counterparty = *params.Counterparty
+	}
+
+	if params.Status != nil {
+		filter.Status = string(*params.Status)
+	}
+
+	var cursor string
+	if params.Cursor != nil {
+		cursor = *params.Cursor
+	}
+
+	transactions, nextCursor, err := hdl.appService.Shop.Transactions(ctx, int(userId), cursor, filter)
+	if err != nil {
+		errorResponse(ctx, err)
+		return
+	}
+
+	items := make([]oapi.Transaction, 0, len(transactions))
+
+	for _, transaction := range transactions {
+		id := transaction.Id
+		direction := oapi.TransactionDirection(transaction.Direction)
+		counterparty := transaction.Counterparty
+		amount := transaction.Amount
+		status := oapi.TransactionStatus(transaction.Status)
+		createdAt := transaction.CreatedAt
+
+		items = append(items, oapi.Transaction{
+			Id:           &id,
+			Direction:    &direction,
+			Counterparty: &counterparty,
+			Amount:       &amount,
+			Message:      transaction.Message,
+			Status:       &status,
+			ExpiresAt:    transaction.ExpiresAt,
+			ReversalOf:   transaction.ReversalOf,
+			CreatedAt:    &createdAt,
+		})
+	}
+
+	res := oapi.TransactionsResponse{
+		Items: &items,
+	}
+
+	if nextCursor != "" {
+		res.NextCursor = &nextCursor
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+func (hdl *Handler) GetApiProducts(ctx *gin.Context, params oapi.GetApiProductsParams) {
+	var filter models.ProductsFilter
+
+	if params.Sort != nil {
+		filter.SortBy = string(*params.Sort)
+	}
+
+	if params.Order != nil {
+		filter.Order = string(*params.Order)
+	}
+
+	if params.Limit != nil {
+		filter.Limit = *params.Limit
+	}
+
+	if params.Offset != nil {
+		filter.Offset = *params.Offset
+	}
+
+	products, total, err := hdl.appService.Shop.Products(ctx, filter)
+	if err != nil {
+		errorResponse(ctx, err)
+		return
+	}
+
+	items := make([]oapi.Product, 0, len(products))
+
+	for _, product := range products {
+		name := product.Name
+		price := product.Price
+		available := product.Available
+
+		items = append(items, oapi.Product{
+			Name:      &name,
+			Price:     &price,
+			Available: &available,
+			Stock:     product.Stock,
+		})
+	}
+
+	res := oapi.ProductsResponse{
+		Items: &items,
+		Total: &total,
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
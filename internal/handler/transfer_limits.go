@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/models"
+	"github.com/MaksimovDenis/Avito_merch_shop/pkg/protocol/oapi"
+	"github.com/gin-gonic/gin"
+)
+
+func (hdl *Handler) GetApiAdminUsersUsernameTransferLimits(ctx *gin.Context, username string) {
+	limits, err := hdl.appService.TransferLimits.TransferLimits(ctx, username)
+	if err != nil {
+		errorResponse(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, toOapiTransferLimits(limits))
+}
+
+func (hdl *Handler) PutApiAdminUsersUsernameTransferLimits(ctx *gin.Context, username string) {
+	var updateReq oapi.TransferLimitsUpdateRequest
+
+	if err := ctx.BindJSON(&updateReq); err != nil {
+		hdl.log.Error().Err(err).Msg("failed to parse request body")
+		ctx.JSON(http.StatusBadRequest, errorBody(ctx, "bad_request"))
+
+		return
+	}
+
+	override := models.TransferLimitsOverride{
+		MaxAmount:   updateReq.MaxAmount,
+		DailyLimit:  updateReq.DailyLimit,
+		WeeklyLimit: updateReq.WeeklyLimit,
+		HourlyCount: updateReq.HourlyCount,
+	}
+
+	limits, err := hdl.appService.TransferLimits.SetTransferLimits(ctx, username, override)
+	if err != nil {
+		errorResponse(ctx, err)
+		return
+	}
+
+	hdl.log.Info().Msgf("admin %v set transfer limits of user %v", adminName(ctx), username)
+
+	ctx.JSON(http.StatusOK, toOapiTransferLimits(limits))
+}
+
+func toOapiTransferLimits(limits models.TransferLimits) oapi.TransferLimits {
+	return oapi.TransferLimits{
+		MaxAmount:   &limits.MaxAmount,
+		DailyLimit:  &limits.DailyLimit,
+		WeeklyLimit: &limits.WeeklyLimit,
+		HourlyCount: &limits.HourlyCount,
+	}
+}
@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/MaksimovDenis/Avito_merch_shop/pkg/protocol/oapi"
+	"github.com/gin-gonic/gin"
+)
+
+func (hdl *Handler) PostApiAdminTransactionsIdReverse(ctx *gin.Context, id int) {
+	var reverseReq oapi.TransferReversalRequest
+
+	if err := ctx.BindJSON(&reverseReq); err != nil {
+		hdl.log.Error().Err(err).Msg("failed to parse request body")
+		ctx.JSON(http.StatusBadRequest, errorBody(ctx, "bad_request"))
+
+		return
+	}
+
+	force := reverseReq.Force != nil && *reverseReq.Force
+
+	reversal, err := hdl.appService.TransferReversal.ReverseTransfer(ctx, adminName(ctx), id, reverseReq.Reason, force)
+	if err != nil {
+		errorResponse(ctx, err)
+		return
+	}
+
+	hdl.log.Info().Msgf("admin %v reversed transaction %v (forced: %v): %v",
+		reversal.ReversedBy, reversal.TransactionId, reversal.Forced, reversal.Reason)
+
+	ctx.JSON(http.StatusOK, oapi.TransferReversal{
+		Id:            reversal.Id,
+		TransactionId: reversal.TransactionId,
+		ReversalId:    reversal.ReversalId,
+		ReversedBy:    reversal.ReversedBy,
+		Reason:        reversal.Reason,
+		Forced:        reversal.Forced,
+		CreatedAt:     reversal.CreatedAt,
+	})
+}
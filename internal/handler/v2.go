@@ -0,0 +1,247 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/models"
+	"github.com/MaksimovDenis/Avito_merch_shop/pkg/protocol/oapiv2"
+	"github.com/MaksimovDenis/Avito_merch_shop/pkg/token"
+	"github.com/gin-gonic/gin"
+)
+
+func (hdl *Handler) PostApiV2Auth(ctx *gin.Context) {
+	var authReq oapiv2.AuthRequest
+
+	if err := ctx.BindJSON(&authReq); err != nil {
+		hdl.log.Error().Err(err).Msg("failed to parse request body")
+		ctx.JSON(http.StatusBadRequest, errorBody(ctx, "bad_request"))
+
+		return
+	}
+
+	modelReq := models.AuthReq{
+		Username: authReq.Username,
+		Password: authReq.Password,
+	}
+
+	token, err := hdl.appService.Authorization.Auth(ctx, modelReq)
+	if err != nil {
+		hdl.log.Error().Err(err).Msg("failed to auth user")
+		errorResponse(ctx, err)
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, oapiv2.AuthResponse{Token: token})
+}
+
+func (hdl *Handler) GetApiV2Me(ctx *gin.Context) {
+	claims, ok := ctx.Get("user")
+	if !ok {
+		hdl.log.Error().Msg("user claims not found in context")
+		ctx.JSON(http.StatusUnauthorized, errorBody(ctx, "unauthorized"))
+
+		return
+	}
+
+	userClaims := claims.(*token.UserClaims)
+
+	coins, items, _, _, err := hdl.appService.Shop.Info(ctx, userClaims.UserName)
+	if err != nil {
+		errorResponse(ctx, err)
+		return
+	}
+
+	expiringLots, err := hdl.appService.Shop.ExpiringCoins(ctx, int(userClaims.ID))
+	if err != nil {
+		errorResponse(ctx, err)
+		return
+	}
+
+	res := oapiv2.Me{
+		Username:      userClaims.UserName,
+		Coins:         coins,
+		Inventory:     make([]oapiv2.InventoryItem, 0, len(items)),
+		ExpiringCoins: make([]oapiv2.ExpiringCoins, 0, len(expiringLots)),
+	}
+
+	for _, item := range items {
+		res.Inventory = append(res.Inventory, oapiv2.InventoryItem{
+			Item:     item.Name,
+			Quantity: item.Quantity,
+		})
+	}
+
+	for _, lot := range expiringLots {
+		res.ExpiringCoins = append(res.ExpiringCoins, oapiv2.ExpiringCoins{
+			Amount:    lot.Remaining,
+			ExpiresAt: lot.ExpiresAt,
+		})
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+func (hdl *Handler) PostApiV2Purchases(ctx *gin.Context, params oapiv2.PostApiV2PurchasesParams) {
+	var purchaseReq oapiv2.PurchaseCreateRequest
+
+	if err := ctx.BindJSON(&purchaseReq); err != nil {
+		hdl.log.Error().Err(err).Msg("failed to parse request body")
+		ctx.JSON(http.StatusBadRequest, errorBody(ctx, "bad_request"))
+
+		return
+	}
+
+	claims, ok := ctx.Get("user")
+	if !ok {
+		hdl.log.Error().Msg("user claims not found in context")
+		ctx.JSON(http.StatusUnauthorized, errorBody(ctx, "unauthorized"))
+
+		return
+	}
+
+	userId := int(claims.(*token.UserClaims).ID)
+
+	quantity := 1
+	if purchaseReq.Quantity != nil {
+		quantity = *purchaseReq.Quantity
+	}
+
+	var idempotencyKey string
+	if params.IdempotencyKey != nil {
+		idempotencyKey = *params.IdempotencyKey
+	}
+
+	purchaseId, err := hdl.appService.Shop.BuyItem(ctx, userId, purchaseReq.Item, quantity, idempotencyKey)
+	if err != nil {
+		errorResponse(ctx, err)
+		return
+	}
+
+	hdl.log.Info().Msgf("userId %v bought %v x%v", userId, purchaseReq.Item, quantity)
+
+	purchase, err := hdl.appService.Shop.Purchase(ctx, userId, purchaseId)
+	if err != nil {
+		errorResponse(ctx, err)
+		return
+	}
+
+	ctx.Header("Location", fmt.Sprintf("/api/v2/purchases/%d", purchase.Id))
+	ctx.JSON(http.StatusCreated, v2Purchase(purchase))
+}
+
+func (hdl *Handler) GetApiV2PurchasesId(ctx *gin.Context, id int) {
+	claims, ok := ctx.Get("user")
+	if !ok {
+		hdl.log.Error().Msg("user claims not found in context")
+		ctx.JSON(http.StatusUnauthorized, errorBody(ctx, "unauthorized"))
+
+		return
+	}
+
+	purchase, err := hdl.appService.Shop.Purchase(ctx, int(claims.(*token.UserClaims).ID), id)
+	if err != nil {
+		errorResponse(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, v2Purchase(purchase))
+}
+
+func (hdl *Handler) PostApiV2Transfers(ctx *gin.Context, params oapiv2.PostApiV2TransfersParams) {
+	var transferReq oapiv2.TransferCreateRequest
+
+	if err := ctx.BindJSON(&transferReq); err != nil {
+		hdl.log.Error().Err(err).Msg("failed to parse request body")
+		ctx.JSON(http.StatusBadRequest, errorBody(ctx, "bad_request"))
+
+		return
+	}
+
+	claims, ok := ctx.Get("user")
+	if !ok {
+		hdl.log.Error().Msg("user claims not found in context")
+		ctx.JSON(http.StatusUnauthorized, errorBody(ctx, "unauthorized"))
+
+		return
+	}
+
+	userClaims := claims.(*token.UserClaims)
+	sender := userClaims.UserName
+
+	var idempotencyKey string
+	if params.IdempotencyKey != nil {
+		idempotencyKey = *params.IdempotencyKey
+	}
+
+	var message string
+	if transferReq.Message != nil {
+		message = *transferReq.Message
+	}
+
+	send := hdl.appService.Shop.SendCoins
+	if transferReq.RequireAcceptance != nil && *transferReq.RequireAcceptance {
+		send = hdl.appService.Shop.SendCoinsPending
+	}
+
+	transactionId, err := send(ctx, sender, transferReq.ToUser, transferReq.Amount, message, idempotencyKey)
+	if err != nil {
+		errorResponse(ctx, err)
+		return
+	}
+
+	transfer, err := hdl.appService.Shop.SentTransfer(ctx, int(userClaims.ID), transactionId)
+	if err != nil {
+		errorResponse(ctx, err)
+		return
+	}
+
+	hdl.log.Info().Msgf("user %v sent %v coins to user %v (%v)", sender, transfer.Amount, transfer.Counterparty,
+		transfer.Status)
+
+	ctx.Header("Location", fmt.Sprintf("/api/v2/transfers/%d", transfer.Id))
+	ctx.JSON(http.StatusCreated, v2Transfer(transfer))
+}
+
+func (hdl *Handler) GetApiV2TransfersId(ctx *gin.Context, id int) {
+	claims, ok := ctx.Get("user")
+	if !ok {
+		hdl.log.Error().Msg("user claims not found in context")
+		ctx.JSON(http.StatusUnauthorized, errorBody(ctx, "unauthorized"))
+
+		return
+	}
+
+	transfer, err := hdl.appService.Shop.SentTransfer(ctx, int(claims.(*token.UserClaims).ID), id)
+	if err != nil {
+		errorResponse(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, v2Transfer(transfer))
+}
+
+func v2Purchase(purchase models.PurchaseRecord) oapiv2.Purchase {
+	return oapiv2.Purchase{
+		Id:         purchase.Id,
+		Item:       purchase.Name,
+		Quantity:   purchase.Quantity,
+		UnitPrice:  purchase.UnitPrice,
+		TotalPrice: purchase.TotalPrice,
+		Status:     oapiv2.PurchaseStatus(purchase.Status),
+		CreatedAt:  purchase.PurchasedAt,
+	}
+}
+
+func v2Transfer(transfer models.Transaction) oapiv2.Transfer {
+	return oapiv2.Transfer{
+		Id:        transfer.Id,
+		ToUser:    transfer.Counterparty,
+		Amount:    transfer.Amount,
+		Message:   transfer.Message,
+		Status:    oapiv2.TransferStatus(transfer.Status),
+		ExpiresAt: transfer.ExpiresAt,
+		CreatedAt: transfer.CreatedAt,
+	}
+}
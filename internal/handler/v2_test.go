@@ -0,0 +1,230 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/service"
+	"github.com/MaksimovDenis/Avito_merch_shop/pkg/protocol/oapiv2"
+	"github.com/MaksimovDenis/Avito_merch_shop/pkg/token"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestV2Routes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tokenMaker := token.NewJWTMaker("supersecretkey")
+
+	accessToken, _, err := tokenMaker.CreateToken(1, "alice", token.RoleUser, time.Hour)
+	require.NoError(t, err)
+
+	shop := &fakeShop{}
+
+	hdl := NewHandler(service.Service{Shop: shop}, *tokenMaker, zerolog.Nop(), testMetrics())
+	hdl.EnableResponseValidation()
+
+	router, err := hdl.InitRoutes()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name               string
+		method             string
+		path               string
+		body               string
+		withoutToken       bool
+		expectedStatus     int
+		expectedBody       string
+		expectedLocation   string
+		expectedDeprecated bool
+	}{
+		{
+			name:           "Me",
+			method:         http.MethodGet,
+			path:           "/api/v2/me",
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"username":"alice","coins":1000,"inventory":[],"expiringCoins":[]}`,
+		},
+		{
+			name:           "Me without token",
+			method:         http.MethodGet,
+			path:           "/api/v2/me",
+			withoutToken:   true,
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "Purchase",
+			method:         http.MethodPost,
+			path:           "/api/v2/purchases",
+			body:           `{"item": "cup", "quantity": 2}`,
+			expectedStatus: http.StatusCreated,
+			expectedBody: `{"id":1,"item":"cup","quantity":2,"unitPrice":10,"totalPrice":20,"status":"completed",` +
+				`"createdAt":"2026-01-02T03:04:05Z"}`,
+			expectedLocation: "/api/v2/purchases/1",
+		},
+		{
+			name:           "Purchase with default quantity",
+			method:         http.MethodPost,
+			path:           "/api/v2/purchases",
+			body:           `{"item": "pen"}`,
+			expectedStatus: http.StatusCreated,
+			expectedBody: `{"id":2,"item":"pen","quantity":1,"unitPrice":10,"totalPrice":10,"status":"completed",` +
+				`"createdAt":"2026-01-02T03:04:05Z"}`,
+			expectedLocation: "/api/v2/purchases/2",
+		},
+		{
+			name:           "Get purchase",
+			method:         http.MethodGet,
+			path:           "/api/v2/purchases/1",
+			expectedStatus: http.StatusOK,
+			expectedBody: `{"id":1,"item":"cup","quantity":2,"unitPrice":10,"totalPrice":20,"status":"completed",` +
+				`"createdAt":"2026-01-02T03:04:05Z"}`,
+		},
+		{
+			name:           "Get missing purchase",
+			method:         http.MethodGet,
+			path:           "/api/v2/purchases/99",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Purchase without enough coins",
+			method:         http.MethodPost,
+			path:           "/api/v2/purchases",
+			body:           `{"item": "cup", "quantity": 101}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "Purchase without item",
+			method:         http.MethodPost,
+			path:           "/api/v2/purchases",
+			body:           `{"quantity": 2}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Purchase through GET",
+			method:         http.MethodGet,
+			path:           "/api/v2/purchases",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Transfer",
+			method:         http.MethodPost,
+			path:           "/api/v2/transfers",
+			body:           `{"toUser": "bob", "amount": 10, "message": "спасибо"}`,
+			expectedStatus: http.StatusCreated,
+			expectedBody: `{"id":1,"toUser":"bob","amount":10,"message":"спасибо","status":"completed",` +
+				`"createdAt":"2026-01-02T03:04:05Z"}`,
+			expectedLocation: "/api/v2/transfers/1",
+		},
+		{
+			name:           "Pending transfer",
+			method:         http.MethodPost,
+			path:           "/api/v2/transfers",
+			body:           `{"toUser": "bob", "amount": 20, "requireAcceptance": true}`,
+			expectedStatus: http.StatusCreated,
+			expectedBody: `{"id":2,"toUser":"bob","amount":20,"status":"pending",` +
+				`"expiresAt":"2026-01-05T03:04:05Z","createdAt":"2026-01-02T03:04:05Z"}`,
+			expectedLocation: "/api/v2/transfers/2",
+		},
+		{
+			name:           "Get transfer",
+			method:         http.MethodGet,
+			path:           "/api/v2/transfers/2",
+			expectedStatus: http.StatusOK,
+			expectedBody: `{"id":2,"toUser":"bob","amount":20,"status":"pending",` +
+				`"expiresAt":"2026-01-05T03:04:05Z","createdAt":"2026-01-02T03:04:05Z"}`,
+		},
+		{
+			name:           "Transfer with invalid amount",
+			method:         http.MethodPost,
+			path:           "/api/v2/transfers",
+			body:           `{"toUser": "bob", "amount": 0}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:               "Deprecated v1 purchase",
+			method:             http.MethodGet,
+			path:               "/api/buy/book",
+			expectedStatus:     http.StatusOK,
+			expectedDeprecated: true,
+		},
+		{
+			name:               "Deprecated v1 with invalid request",
+			method:             http.MethodPost,
+			path:               "/api/sendCoin",
+			body:               `{"toUser": "bob"}`,
+			expectedStatus:     http.StatusBadRequest,
+			expectedDeprecated: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			responseRecord := httptest.NewRecorder()
+
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+
+			if !tt.withoutToken {
+				req.Header.Set("Authorization", "Bearer "+accessToken)
+			}
+
+			router.ServeHTTP(responseRecord, req)
+
+			require.Equal(t, tt.expectedStatus, responseRecord.Code, responseRecord.Body.String())
+
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, responseRecord.Body.String())
+			}
+
+			assert.Equal(t, tt.expectedLocation, responseRecord.Header().Get("Location"))
+
+			if tt.expectedDeprecated {
+				assert.Equal(t, v1DeprecatedAt, responseRecord.Header().Get("Deprecation"))
+				assert.Equal(t, v1SuccessorLink, responseRecord.Header().Get("Link"))
+			} else {
+				assert.Empty(t, responseRecord.Header().Get("Deprecation"))
+			}
+		})
+	}
+
+	assert.Equal(t, []string{"cup", "pen", "book"}, shop.bought)
+	assert.Equal(t, []int{10}, shop.sent)
+	assert.Equal(t, []int{20}, shop.pending)
+}
+
+func TestV2AuthIsPublic(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	specRouter, err := newSpecRouter(oapiv2.GetSwagger)
+	require.NoError(t, err)
+
+	tokenMaker := token.NewJWTMaker("supersecretkey")
+
+	router := gin.New()
+	router.Use(GetValidationMiddlewareFunc(specRouter, tokenMaker, false))
+	router.Use(GetAuthMiddlewareFunc(tokenMaker))
+	router.POST("/api/v2/auth", func(ctx *gin.Context) {
+		ctx.JSON(http.StatusOK, oapiv2.AuthResponse{Token: "token"})
+	})
+
+	responseRecord := httptest.NewRecorder()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v2/auth",
+		strings.NewReader(`{"username": "alice", "password": "secret"}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	router.ServeHTTP(responseRecord, req)
+
+	assert.Equal(t, http.StatusOK, responseRecord.Code)
+
+	var res oapiv2.AuthResponse
+	require.NoError(t, json.Unmarshal(responseRecord.Body.Bytes(), &res))
+	assert.Equal(t, "token", res.Token)
+}
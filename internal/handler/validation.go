@@ -0,0 +1,317 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/MaksimovDenis/Avito_merch_shop/pkg/protocol/oapi"
+	"github.com/MaksimovDenis/Avito_merch_shop/pkg/token"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/legacy"
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	// Браузеры в Windows отправляют CSV-файлы с этим типом содержимого.
+	openapi3filter.RegisterBodyDecoder("application/vnd.ms-excel", openapi3filter.FileBodyDecoder)
+}
+
+// newSpecRouter возвращает маршрутизатор по встроенной схеме API.
+func newSpecRouter(getSwagger func() (*openapi3.T, error)) (routers.Router, error) {
+	swagger, err := getSwagger()
+	if err != nil {
+		return nil, err
+	}
+
+	// Запросы сопоставляются только по пути: адрес сервера из схемы
+	// не совпадает с адресом, на котором сервис запущен.
+	swagger.Servers = nil
+
+	router, err := legacy.NewRouter(swagger)
+	if err != nil {
+		return nil, err
+	}
+
+	return nonEmptyParamsRouter{router}, nil
+}
+
+// nonEmptyParamsRouter не сопоставляет запрос с операцией, если параметр пути
+// оказался пустым: legacy.Router сопоставляет /api/v2/purchases
+// с /api/v2/purchases/{id} при пустом id.
+type nonEmptyParamsRouter struct {
+	routers.Router
+}
+
+func (r nonEmptyParamsRouter) FindRoute(req *http.Request) (*routers.Route, map[string]string, error) {
+	route, pathParams, err := r.Router.FindRoute(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, value := range pathParams {
+		if value == "" {
+			return nil, nil, routers.ErrPathNotFound
+		}
+	}
+
+	return route, pathParams, nil
+}
+
+// specRouters сопоставляет запрос со схемами нескольких версий API по очереди.
+type specRouters []routers.Router
+
+func (rs specRouters) FindRoute(req *http.Request) (*routers.Route, map[string]string, error) {
+	for _, specRouter := range rs {
+		route, pathParams, err := specRouter.FindRoute(req)
+		if err == nil {
+			return route, pathParams, nil
+		}
+	}
+
+	return nil, nil, routers.ErrPathNotFound
+}
+
+// GetValidationMiddlewareFunc проверяет запрос по схеме API. Сначала проверяется
+// токен для операций с security: запрос без действующего токена отклоняется
+// с кодом 401. Затем для административных операций проверяется роль: обычному
+// пользователю отвечаем 403, не раскрывая схему тела запроса. Только после этого
+// проверяются параметры пути, запроса и заголовков, а также тело запроса:
+// некорректный запрос отклоняется с кодом 400 и списком ошибок по полям.
+// Тело запроса больше MaxRequestBodySize отклоняется с кодом 413. Запросы
+// к путям, которых нет в схеме, пропускаются без проверки.
+// При validateResponses ответ тоже проверяется по схеме и заменяется ошибкой 500,
+// если не соответствует ей. Этот режим предназначен для тестов: ответ
+// буферизуется целиком.
+func GetValidationMiddlewareFunc(specRouter routers.Router, tokenMaker *token.JWTMaker,
+	validateResponses bool) func(ctx *gin.Context) {
+	return func(ctx *gin.Context) {
+		ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, MaxRequestBodySize)
+
+		route, pathParams, err := specRouter.FindRoute(ctx.Request)
+		if err != nil {
+			ctx.Next()
+			return
+		}
+
+		input := &openapi3filter.RequestValidationInput{
+			Request:    ctx.Request,
+			PathParams: pathParams,
+			Route:      route,
+			Options: &openapi3filter.Options{
+				MultiError:         true,
+				AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+			},
+		}
+
+		securityInput := *input
+		securityInput.Options = &openapi3filter.Options{
+			AuthenticationFunc: func(context.Context, *openapi3filter.AuthenticationInput) error {
+				_, err := verifyClaimsFromAuthHeader(ctx, *tokenMaker)
+				return err
+			},
+		}
+
+		err = openapi3filter.ValidateSecurityRequirements(ctx.Request.Context(), &securityInput,
+			operationSecurity(route))
+		if err != nil {
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorBody(ctx, "unauthorized"))
+			return
+		}
+
+		if strings.HasPrefix(ctx.Request.URL.Path, adminPathPrefix) || operationRequiresAdmin(route) {
+			claims, err := verifyClaimsFromAuthHeader(ctx, *tokenMaker)
+			if err != nil {
+				ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorBody(ctx, "unauthorized"))
+				return
+			}
+
+			if claims.Role != token.RoleAdmin {
+				ctx.AbortWithStatusJSON(http.StatusForbidden, errorBody(ctx, "forbidden"))
+				return
+			}
+		}
+
+		// Токен уже проверен, поэтому при проверке параметров и тела
+		// требования security считаются выполненными.
+		if err := openapi3filter.ValidateRequest(ctx.Request.Context(), input); err != nil {
+			validationErrorResponse(ctx, err)
+			return
+		}
+
+		if !validateResponses {
+			ctx.Next()
+			return
+		}
+
+		writer := &bufferedResponseWriter{ResponseWriter: ctx.Writer, status: http.StatusOK}
+		ctx.Writer = writer
+
+		ctx.Next()
+
+		ctx.Writer = writer.ResponseWriter
+
+		err = openapi3filter.ValidateResponse(ctx.Request.Context(), &openapi3filter.ResponseValidationInput{
+			RequestValidationInput: input,
+			Status:                 writer.status,
+			Header:                 writer.Header(),
+			Body:                   io.NopCloser(bytes.NewReader(writer.body.Bytes())),
+			Options: &openapi3filter.Options{
+				MultiError:            true,
+				IncludeResponseStatus: true,
+			},
+		})
+		if err != nil {
+			message := err.Error()
+			code := "invalid_response"
+
+			ctx.JSON(http.StatusInternalServerError, oapi.ErrorResponse{Error: &message, Code: &code})
+
+			return
+		}
+
+		ctx.Writer.WriteHeader(writer.status)
+		_, _ = ctx.Writer.Write(writer.body.Bytes())
+	}
+}
+
+// validationErrorResponse отклоняет запрос, не прошедший проверку по схеме.
+func validationErrorResponse(ctx *gin.Context, err error) {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		ctx.AbortWithStatusJSON(http.StatusRequestEntityTooLarge, errorBody(ctx, "request_too_large"))
+		return
+	}
+
+	res := errorBody(ctx, "bad_request")
+	details := validationDetails(err, oapi.ValidationErrorDetailInBody, "")
+	res.Details = &details
+
+	ctx.AbortWithStatusJSON(http.StatusBadRequest, res)
+}
+
+// operationSecurity возвращает требования security операции, а если они
+// не заданы, общие требования схемы.
+func operationSecurity(route *routers.Route) openapi3.SecurityRequirements {
+	if route.Operation.Security != nil {
+		return *route.Operation.Security
+	}
+
+	return route.Spec.Security
+}
+
+// operationRequiresAdmin сообщает, помечена ли операция в схеме скоупом admin.
+func operationRequiresAdmin(route *routers.Route) bool {
+	for _, requirement := range operationSecurity(route) {
+		for _, scopes := range requirement {
+			if slices.Contains(scopes, token.RoleAdmin) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// validationDetails раскладывает ошибку проверки запроса на ошибки отдельных полей.
+func validationDetails(err error, in oapi.ValidationErrorDetailIn, field string) []oapi.ValidationErrorDetail {
+	switch err := err.(type) {
+	case openapi3.MultiError:
+		var details []oapi.ValidationErrorDetail
+		for _, item := range err {
+			details = append(details, validationDetails(item, in, field)...)
+		}
+
+		return details
+	case *openapi3filter.RequestError:
+		if err.Parameter != nil {
+			in = oapi.ValidationErrorDetailIn(err.Parameter.In)
+			field = err.Parameter.Name
+		}
+
+		switch err.Err.(type) {
+		case openapi3.MultiError, *openapi3.SchemaError:
+			return validationDetails(err.Err, in, field)
+		}
+
+		return []oapi.ValidationErrorDetail{validationDetail(in, field, requestErrorReason(err))}
+	case *openapi3.SchemaError:
+		if path := err.JSONPointer(); len(path) > 0 {
+			if field != "" {
+				path = append([]string{field}, path...)
+			}
+
+			field = strings.Join(path, ".")
+		}
+
+		return []oapi.ValidationErrorDetail{validationDetail(in, field, err.Reason)}
+	default:
+		return []oapi.ValidationErrorDetail{validationDetail(in, field, err.Error())}
+	}
+}
+
+func validationDetail(in oapi.ValidationErrorDetailIn, field, message string) oapi.ValidationErrorDetail {
+	detail := oapi.ValidationErrorDetail{In: in, Message: message}
+	if field != "" {
+		detail.Field = &field
+	}
+
+	return detail
+}
+
+// requestErrorReason возвращает описание ошибки без указания параметра,
+// который и так передаётся в поле field.
+func requestErrorReason(err *openapi3filter.RequestError) string {
+	switch {
+	case err.Err == nil:
+		return err.Reason
+	case err.Reason == "" || err.Reason == err.Err.Error():
+		return err.Err.Error()
+	default:
+		return err.Reason + ": " + err.Err.Error()
+	}
+}
+
+// bufferedResponseWriter накапливает ответ, чтобы проверить его по схеме
+// до отправки клиенту.
+type bufferedResponseWriter struct {
+	gin.ResponseWriter
+	status  int
+	written bool
+	body    bytes.Buffer
+}
+
+func (w *bufferedResponseWriter) WriteHeader(status int) {
+	w.status = status
+	w.written = true
+}
+
+func (w *bufferedResponseWriter) WriteHeaderNow() {}
+
+func (w *bufferedResponseWriter) Write(data []byte) (int, error) {
+	w.written = true
+	return w.body.Write(data)
+}
+
+func (w *bufferedResponseWriter) WriteString(s string) (int, error) {
+	w.written = true
+	return w.body.WriteString(s)
+}
+
+func (w *bufferedResponseWriter) Status() int {
+	return w.status
+}
+
+func (w *bufferedResponseWriter) Size() int {
+	return w.body.Len()
+}
+
+func (w *bufferedResponseWriter) Written() bool {
+	return w.written
+}
@@ -0,0 +1,294 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	errresponse "github.com/MaksimovDenis/Avito_merch_shop/internal/err_response"
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/i18n"
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/metrics"
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/models"
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/service"
+	"github.com/MaksimovDenis/Avito_merch_shop/pkg/protocol/oapi"
+	"github.com/MaksimovDenis/Avito_merch_shop/pkg/token"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testMetrics создаёт метрики один раз: повторная регистрация в Prometheus
+// завершается паникой.
+var testMetrics = sync.OnceValue(metrics.New)
+
+// fakeCreatedAt - время создания покупок и переводов в fakeShop.
+var fakeCreatedAt = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+// fakeShop реализует только методы, которые вызываются в тестах обработчиков.
+// Цена любого товара - 10 монет, баланс пользователя - 1000 монет.
+type fakeShop struct {
+	service.Shop
+	sent      []int
+	pending   []int
+	bought    []string
+	purchases []models.PurchaseRecord
+	transfers []models.Transaction
+}
+
+func (fs *fakeShop) SendCoins(_ context.Context, _ string, receiver string, amount int, message string,
+	_ string) (int, error) {
+	fs.sent = append(fs.sent, amount)
+	return fs.addTransfer(receiver, amount, message, models.TransferStatusCompleted, nil), nil
+}
+
+func (fs *fakeShop) SendCoinsPending(_ context.Context, _ string, receiver string, amount int, message string,
+	_ string) (int, error) {
+	fs.pending = append(fs.pending, amount)
+
+	expiresAt := fakeCreatedAt.Add(72 * time.Hour)
+
+	return fs.addTransfer(receiver, amount, message, models.TransferStatusPending, &expiresAt), nil
+}
+
+func (fs *fakeShop) addTransfer(receiver string, amount int, message string, status string,
+	expiresAt *time.Time) int {
+	transfer := models.Transaction{
+		Id:           len(fs.transfers) + 1,
+		Direction:    models.TransactionDirectionSent,
+		Counterparty: receiver,
+		Amount:       amount,
+		Status:       status,
+		ExpiresAt:    expiresAt,
+		CreatedAt:    fakeCreatedAt,
+	}
+
+	if message != "" {
+		transfer.Message = &message
+	}
+
+	fs.transfers = append(fs.transfers, transfer)
+
+	return transfer.Id
+}
+
+func (fs *fakeShop) SentTransfer(_ context.Context, _ int, transactionId int) (models.Transaction, error) {
+	if transactionId < 1 || transactionId > len(fs.transfers) {
+		return models.Transaction{}, errresponse.ErrResponse(pgx.ErrNoRows, i18n.EntityTransfer)
+	}
+
+	return fs.transfers[transactionId-1], nil
+}
+
+func (fs *fakeShop) BuyItem(_ context.Context, _ int, productName string, quantity int, _ string) (int, error) {
+	if 10*quantity > 1000 {
+		return 0, errresponse.ErrResponse(&pgconn.PgError{Code: "23514", ConstraintName: "users_coins_check"})
+	}
+
+	fs.bought = append(fs.bought, productName)
+	fs.purchases = append(fs.purchases, models.PurchaseRecord{
+		Id:          len(fs.purchases) + 1,
+		Name:        productName,
+		Quantity:    quantity,
+		UnitPrice:   10,
+		TotalPrice:  10 * quantity,
+		Status:      models.PurchaseStatusCompleted,
+		PurchasedAt: fakeCreatedAt,
+	})
+
+	return len(fs.purchases), nil
+}
+
+func (fs *fakeShop) Purchase(_ context.Context, _ int, purchaseId int) (models.PurchaseRecord, error) {
+	if purchaseId < 1 || purchaseId > len(fs.purchases) {
+		return models.PurchaseRecord{}, errresponse.ErrResponse(pgx.ErrNoRows, i18n.EntityPurchase)
+	}
+
+	return fs.purchases[purchaseId-1], nil
+}
+
+func (fs *fakeShop) Info(context.Context, string) (int, []models.Items, []models.SentCoins,
+	[]models.ReceivedCoins, error) {
+	return 1000, nil, nil, nil, nil
+}
+
+func (fs *fakeShop) ExpiringCoins(context.Context, int) ([]models.CoinLot, error) {
+	return nil, nil
+}
+
+func TestValidationMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tokenMaker := token.NewJWTMaker("supersecretkey")
+
+	accessToken, _, err := tokenMaker.CreateToken(1, "alice", token.RoleUser, time.Hour)
+	require.NoError(t, err)
+
+	shop := &fakeShop{}
+
+	hdl := NewHandler(service.Service{Shop: shop}, *tokenMaker, zerolog.Nop(), testMetrics())
+	hdl.EnableResponseValidation()
+
+	router, err := hdl.InitRoutes()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		body           string
+		withoutToken   bool
+		expectedStatus int
+		expectedCode   string
+		expectedField  string
+		expectedIn     oapi.ValidationErrorDetailIn
+	}{
+		{
+			name:           "Valid request",
+			method:         http.MethodPost,
+			path:           "/api/sendCoin",
+			body:           `{"toUser": "bob", "amount": 10}`,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Missing required field",
+			method:         http.MethodPost,
+			path:           "/api/sendCoin",
+			body:           `{"toUser": "bob"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "bad_request",
+			expectedField:  "amount",
+			expectedIn:     oapi.ValidationErrorDetailInBody,
+		},
+		{
+			name:           "Wrong field type",
+			method:         http.MethodPost,
+			path:           "/api/sendCoin",
+			body:           `{"toUser": "bob", "amount": "ten"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "bad_request",
+			expectedField:  "amount",
+			expectedIn:     oapi.ValidationErrorDetailInBody,
+		},
+		{
+			name:           "Invalid path parameter",
+			method:         http.MethodPost,
+			path:           "/api/transactions/abc/accept",
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "bad_request",
+			expectedField:  "id",
+			expectedIn:     oapi.ValidationErrorDetailInPath,
+		},
+		{
+			name:           "Missing token",
+			method:         http.MethodPost,
+			path:           "/api/sendCoin",
+			body:           `{"toUser": "bob", "amount": 10}`,
+			withoutToken:   true,
+			expectedStatus: http.StatusUnauthorized,
+			expectedCode:   "unauthorized",
+		},
+		{
+			name:           "Missing token with invalid body",
+			method:         http.MethodPost,
+			path:           "/api/sendCoin",
+			body:           `{"toUser": "bob"}`,
+			withoutToken:   true,
+			expectedStatus: http.StatusUnauthorized,
+			expectedCode:   "unauthorized",
+		},
+		{
+			name:           "Admin operation for non-admin",
+			method:         http.MethodPost,
+			path:           "/api/admin/transactions/1/reverse",
+			body:           `{}`,
+			expectedStatus: http.StatusForbidden,
+			expectedCode:   "forbidden",
+		},
+		{
+			name:           "Valid response",
+			method:         http.MethodGet,
+			path:           "/api/info",
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			responseRecord := httptest.NewRecorder()
+
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+
+			if !tt.withoutToken {
+				req.Header.Set("Authorization", "Bearer "+accessToken)
+			}
+
+			router.ServeHTTP(responseRecord, req)
+
+			require.Equal(t, tt.expectedStatus, responseRecord.Code, responseRecord.Body.String())
+
+			if tt.expectedCode == "" {
+				return
+			}
+
+			var res oapi.ErrorResponse
+			require.NoError(t, json.Unmarshal(responseRecord.Body.Bytes(), &res))
+			require.NotNil(t, res.Code)
+			assert.Equal(t, tt.expectedCode, *res.Code)
+
+			if tt.expectedField == "" {
+				return
+			}
+
+			require.NotNil(t, res.Details)
+			require.NotEmpty(t, *res.Details)
+
+			detail := (*res.Details)[0]
+			assert.Equal(t, tt.expectedIn, detail.In)
+			require.NotNil(t, detail.Field)
+			assert.Equal(t, tt.expectedField, *detail.Field)
+		})
+	}
+
+	assert.Equal(t, []int{10}, shop.sent)
+}
+
+func TestResponseValidation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tokenMaker := token.NewJWTMaker("supersecretkey")
+
+	accessToken, _, err := tokenMaker.CreateToken(1, "alice", token.RoleUser, time.Hour)
+	require.NoError(t, err)
+
+	specRouter, err := newSpecRouter(oapi.GetSwagger)
+	require.NoError(t, err)
+
+	router := gin.New()
+	router.Use(GetValidationMiddlewareFunc(specRouter, tokenMaker, true))
+	router.GET("/api/info", func(ctx *gin.Context) {
+		ctx.JSON(http.StatusOK, gin.H{"coins": "много"})
+	})
+
+	responseRecord := httptest.NewRecorder()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/info", nil)
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	router.ServeHTTP(responseRecord, req)
+
+	assert.Equal(t, http.StatusInternalServerError, responseRecord.Code)
+
+	var res oapi.ErrorResponse
+	require.NoError(t, json.Unmarshal(responseRecord.Body.Bytes(), &res))
+	require.NotNil(t, res.Code)
+	assert.Equal(t, "invalid_response", *res.Code)
+}
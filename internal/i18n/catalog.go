@@ -0,0 +1,228 @@
+package i18n
+
+// catalog содержит сообщения для пользователя по кодам. Коды стабильны
+// и возвращаются клиенту в поле code ответа об ошибке.
+var catalog = map[string]map[string]string{
+	Russian: {
+		// Общие ошибки.
+		"bad_request":        "Неверный запрос",
+		"unauthorized":       "Неавторизован",
+		"request_too_large":  "Слишком большой запрос",
+		"forbidden":          "Недостаточно прав",
+		"not_found":          "%v не найден",
+		"insufficient_funds": "недостаточно средств для покупки",
+		"invalid_data":       "некорректные данные",
+		"already_exists":     "запись уже существует",
+		"database_error":     "ошибка при обновлении данных",
+		"internal_error":     "внутренняя ошибка сервера",
+		"concurrent_update":  "не удалось выполнить операцию из-за конкурентных изменений, повторите запрос",
+
+		// Сущности для сообщения not_found.
+		"entity_user":            "пользователь",
+		"entity_transfer":        "перевод",
+		"entity_payment_request": "запрос",
+		"entity_purchase":        "покупка",
+		"entity_idempotency_key": "ключ идемпотентности",
+
+		// Авторизация.
+		"invalid_credentials":      "неверный логин или пароль",
+		"username_required":        "заполните поле логин",
+		"password_required":        "заполните поле пароль",
+		"password_equals_username": "логин и пароль совпадают",
+		"username_invalid_chars":   "логин содержит недопустимые символы",
+		"password_invalid_chars":   "пароль содержит недопустимые символы",
+
+		// Постраничный вывод и фильтры.
+		"invalid_cursor":          "некорректный курсор",
+		"invalid_page_size":       "недопустимый размер страницы",
+		"invalid_offset":          "недопустимое смещение",
+		"negative_offset":         "смещение не может быть отрицательным",
+		"invalid_sort_field":      "недопустимое поле сортировки",
+		"invalid_sort_order":      "недопустимое направление сортировки",
+		"invalid_period":          "начало периода должно быть раньше его конца",
+		"invalid_transfer_status": "недопустимый статус перевода",
+		"invalid_request_status":  "недопустимый статус запроса",
+
+		// Покупки и корзина.
+		"out_of_stock":              "[%v] нет в наличии",
+		"invalid_quantity":          "количество товара должно быть положительным числом",
+		"cart_empty":                "корзина пуста",
+		"purchase_already_refunded": "покупка уже возвращена",
+		"refund_window_expired":     "срок возврата покупки истёк",
+		"idempotency_key_too_long":  "ключ идемпотентности слишком длинный",
+		"idempotency_key_reused":    "ключ идемпотентности уже использован для другого запроса",
+
+		// Товары.
+		"product_name_required":      "заполните поле название товара",
+		"product_name_invalid_chars": "название товара содержит недопустимые символы",
+		"negative_price":             "цена товара не может быть отрицательной",
+		"negative_stock":             "остаток товара не может быть отрицательным",
+		"no_fields_to_update":        "не переданы поля для обновления",
+		"stock_conflict":             "нельзя одновременно задать остаток и снять ограничение",
+
+		// Переводы.
+		"invalid_transfer_amount":   "сумма перевода должна быть положительным числом",
+		"transfer_message_too_long": "сообщение к переводу слишком длинное",
+		"self_transfer":             "имя отправителя совпадает с именем получателя",
+		"insufficient_coins":        "недостаточно монет для перевода",
+		"transfer_already_resolved": "перевод уже обработан",
+		"transfer_accept_expired":   "срок подтверждения перевода истёк",
+		"no_recipients":             "не указаны получатели перевода",
+		"too_many_recipients":       "слишком много получателей, максимум %d",
+		"duplicate_recipient":       "получатель %s указан несколько раз",
+		"negative_transfer_limit":   "лимит перевода не может быть отрицательным",
+		"transfer_max_amount":       "сумма перевода превышает лимит %d монет",
+		"transfer_daily_limit":      "превышен дневной лимит переводов %d монет",
+		"transfer_weekly_limit":     "превышен недельный лимит переводов %d монет",
+		"transfer_hourly_count":     "превышено количество переводов в час: не более %d",
+		"transfer_limit":            "превышен лимит переводов",
+		"reversal_reason_required":  "необходимо указать причину отмены перевода",
+		"reversal_reason_too_long":  "причина отмены не может быть длиннее %d символов",
+		"transfer_already_reversed": "перевод уже отменён",
+		"transfer_not_reversible":   "отменить можно только выполненный перевод",
+		"receiver_spent_coins":      "получатель уже потратил монеты: баланс %d, к возврату %d",
+
+		// Запросы монет.
+		"invalid_request_amount":   "сумма запроса должна быть положительным числом",
+		"request_reason_too_long":  "причина запроса слишком длинная",
+		"self_payment_request":     "нельзя запросить монеты у самого себя",
+		"request_already_resolved": "запрос уже обработан",
+
+		// Массовое начисление монет.
+		"invalid_csv":                  "неверный формат CSV: %v",
+		"issuance_too_many_rows":       "файл начислений не может содержать больше %d строк",
+		"issuance_empty":               "файл начислений не содержит строк",
+		"issuance_field_count":         "ожидается 3 поля, получено %d",
+		"issuance_username_required":   "не указано имя пользователя",
+		"issuance_amount_not_integer":  "сумма должна быть целым числом",
+		"issuance_amount_not_positive": "сумма должна быть положительным числом",
+		"issuance_amount_too_large":    "сумма не может превышать %d",
+		"issuance_reason_too_long":     "причина не может быть длиннее %d символов",
+		"issuance_user_not_found":      "пользователь %s не найден",
+
+		// Сообщения об успешных операциях.
+		"item_purchased":           "Товар приобретён",
+		"purchase_refunded":        "Покупка возвращена",
+		"transfer_completed":       "Перевод выполнен",
+		"transfer_pending":         "Перевод ожидает подтверждения получателем",
+		"transfer_accepted":        "Перевод принят",
+		"transfer_declined":        "Перевод отклонён",
+		"cart_item_added":          "Товар добавлен в корзину",
+		"cart_item_removed":        "Товар удалён из корзины",
+		"order_placed":             "Заказ оформлен",
+		"payment_request_sent":     "Запрос отправлен",
+		"payment_request_approved": "Запрос одобрен, перевод выполнен",
+		"payment_request_rejected": "Запрос отклонён",
+	},
+	English: {
+		// Общие ошибки.
+		"bad_request":        "Invalid request",
+		"unauthorized":       "Unauthorized",
+		"request_too_large":  "Request body is too large",
+		"forbidden":          "Insufficient permissions",
+		"not_found":          "%v not found",
+		"insufficient_funds": "insufficient funds for the purchase",
+		"invalid_data":       "invalid data",
+		"already_exists":     "record already exists",
+		"database_error":     "failed to update data",
+		"internal_error":     "internal server error",
+		"concurrent_update":  "the operation failed due to concurrent changes, please retry",
+
+		// Сущности для сообщения not_found.
+		"entity_user":            "user",
+		"entity_transfer":        "transfer",
+		"entity_payment_request": "request",
+		"entity_purchase":        "purchase",
+		"entity_idempotency_key": "idempotency key",
+
+		// Авторизация.
+		"invalid_credentials":      "invalid username or password",
+		"username_required":        "username is required",
+		"password_required":        "password is required",
+		"password_equals_username": "password must differ from username",
+		"username_invalid_chars":   "username contains invalid characters",
+		"password_invalid_chars":   "password contains invalid characters",
+
+		// Постраничный вывод и фильтры.
+		"invalid_cursor":          "invalid cursor",
+		"invalid_page_size":       "invalid page size",
+		"invalid_offset":          "invalid offset",
+		"negative_offset":         "offset must not be negative",
+		"invalid_sort_field":      "invalid sort field",
+		"invalid_sort_order":      "invalid sort order",
+		"invalid_period":          "period start must be before its end",
+		"invalid_transfer_status": "invalid transfer status",
+		"invalid_request_status":  "invalid request status",
+
+		// Покупки и корзина.
+		"out_of_stock":              "[%v] is out of stock",
+		"invalid_quantity":          "quantity must be a positive number",
+		"cart_empty":                "cart is empty",
+		"purchase_already_refunded": "purchase has already been refunded",
+		"refund_window_expired":     "refund period has expired",
+		"idempotency_key_too_long":  "idempotency key is too long",
+		"idempotency_key_reused":    "idempotency key has already been used for another request",
+
+		// Товары.
+		"product_name_required":      "product name is required",
+		"product_name_invalid_chars": "product name contains invalid characters",
+		"negative_price":             "price must not be negative",
+		"negative_stock":             "stock must not be negative",
+		"no_fields_to_update":        "no fields to update",
+		"stock_conflict":             "stock cannot be set and removed at the same time",
+
+		// Переводы.
+		"invalid_transfer_amount":   "transfer amount must be a positive number",
+		"transfer_message_too_long": "transfer message is too long",
+		"self_transfer":             "sender and receiver must differ",
+		"insufficient_coins":        "not enough coins for the transfer",
+		"transfer_already_resolved": "transfer has already been processed",
+		"transfer_accept_expired":   "transfer confirmation period has expired",
+		"no_recipients":             "no transfer recipients specified",
+		"too_many_recipients":       "too many recipients, maximum is %d",
+		"duplicate_recipient":       "recipient %s is listed more than once",
+		"negative_transfer_limit":   "transfer limit must not be negative",
+		"transfer_max_amount":       "transfer amount exceeds the limit of %d coins",
+		"transfer_daily_limit":      "daily transfer limit of %d coins exceeded",
+		"transfer_weekly_limit":     "weekly transfer limit of %d coins exceeded",
+		"transfer_hourly_count":     "too many transfers per hour: at most %d allowed",
+		"transfer_limit":            "transfer limit exceeded",
+		"reversal_reason_required":  "reversal reason is required",
+		"reversal_reason_too_long":  "reversal reason must not exceed %d characters",
+		"transfer_already_reversed": "transfer has already been reversed",
+		"transfer_not_reversible":   "only completed transfers can be reversed",
+		"receiver_spent_coins":      "receiver has already spent the coins: balance %d, to return %d",
+
+		// Запросы монет.
+		"invalid_request_amount":   "request amount must be a positive number",
+		"request_reason_too_long":  "request reason is too long",
+		"self_payment_request":     "you cannot request coins from yourself",
+		"request_already_resolved": "request has already been processed",
+
+		// Массовое начисление монет.
+		"invalid_csv":                  "invalid CSV format: %v",
+		"issuance_too_many_rows":       "issuance file must not contain more than %d rows",
+		"issuance_empty":               "issuance file contains no rows",
+		"issuance_field_count":         "expected 3 fields, got %d",
+		"issuance_username_required":   "username is required",
+		"issuance_amount_not_integer":  "amount must be an integer",
+		"issuance_amount_not_positive": "amount must be a positive number",
+		"issuance_amount_too_large":    "amount must not exceed %d",
+		"issuance_reason_too_long":     "reason must not exceed %d characters",
+		"issuance_user_not_found":      "user %s not found",
+
+		// Сообщения об успешных операциях.
+		"item_purchased":           "Item purchased",
+		"purchase_refunded":        "Purchase refunded",
+		"transfer_completed":       "Transfer completed",
+		"transfer_pending":         "Transfer is awaiting confirmation by the receiver",
+		"transfer_accepted":        "Transfer accepted",
+		"transfer_declined":        "Transfer declined",
+		"cart_item_added":          "Item added to cart",
+		"cart_item_removed":        "Item removed from cart",
+		"order_placed":             "Order placed",
+		"payment_request_sent":     "Request sent",
+		"payment_request_approved": "Request approved, transfer completed",
+		"payment_request_rejected": "Request rejected",
+	},
+}
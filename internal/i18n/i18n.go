@@ -0,0 +1,84 @@
+package i18n
+
+import (
+	"fmt"
+
+	"golang.org/x/text/language"
+)
+
+// Поддерживаемые языки сообщений.
+const (
+	Russian = "ru"
+	English = "en"
+
+	// DefaultLanguage используется, если клиент не передал Accept-Language
+	// или ни один из запрошенных языков не поддерживается.
+	DefaultLanguage = Russian
+)
+
+// Key — код сообщения в каталоге. Аргументы этого типа переводятся
+// на язык сообщения вместо подстановки как есть.
+type Key string
+
+// Названия сущностей для сообщений о ненайденных записях.
+const (
+	EntityUser           Key = "entity_user"
+	EntityTransfer       Key = "entity_transfer"
+	EntityPaymentRequest Key = "entity_payment_request"
+	EntityPurchase       Key = "entity_purchase"
+	EntityIdempotencyKey Key = "entity_idempotency_key"
+)
+
+var (
+	supported = []string{Russian, English}
+	matcher   = language.NewMatcher([]language.Tag{language.Russian, language.English})
+)
+
+// Language выбирает язык ответа по значению заголовка Accept-Language
+// с учётом весов q.
+func Language(acceptLanguage string) string {
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return DefaultLanguage
+	}
+
+	_, index, confidence := matcher.Match(tags...)
+	if confidence == language.No {
+		return DefaultLanguage
+	}
+
+	return supported[index]
+}
+
+// Message возвращает сообщение с кодом code на языке lang. Если сообщения
+// нет в каталоге языка, используется язык по умолчанию, а если нет и там, —
+// сам код.
+func Message(lang, code string, args ...any) string {
+	format, ok := catalog[lang][code]
+	if !ok {
+		format, ok = catalog[DefaultLanguage][code]
+	}
+
+	if !ok {
+		return code
+	}
+
+	return fmt.Sprintf(format, Translate(lang, args)...)
+}
+
+// Translate переводит аргументы типа Key на язык lang, остальные
+// аргументы возвращаются без изменений.
+func Translate(lang string, args []any) []any {
+	translated := make([]any, len(args))
+
+	for i, arg := range args {
+		if key, ok := arg.(Key); ok {
+			translated[i] = Message(lang, string(key))
+			continue
+		}
+
+		translated[i] = arg
+	}
+
+	return translated
+}
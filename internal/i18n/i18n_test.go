@@ -0,0 +1,60 @@
+package i18n
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLanguage(t *testing.T) {
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		expected       string
+	}{
+		{name: "Empty header", acceptLanguage: "", expected: Russian},
+		{name: "Russian", acceptLanguage: "ru-RU", expected: Russian},
+		{name: "English", acceptLanguage: "en", expected: English},
+		{name: "English region", acceptLanguage: "en-GB", expected: English},
+		{name: "Weights", acceptLanguage: "ru;q=0.5, en;q=0.9", expected: English},
+		{name: "Fallback to supported", acceptLanguage: "de-DE, en;q=0.7", expected: English},
+		{name: "Unsupported", acceptLanguage: "de-DE, fr", expected: Russian},
+		{name: "Malformed header", acceptLanguage: ";;;", expected: Russian},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Language(tt.acceptLanguage))
+		})
+	}
+}
+
+func TestMessage(t *testing.T) {
+	assert.Equal(t, "недостаточно монет для перевода", Message(Russian, "insufficient_coins"))
+	assert.Equal(t, "not enough coins for the transfer", Message(English, "insufficient_coins"))
+	assert.Equal(t, "too many recipients, maximum is 100", Message(English, "too_many_recipients", 100))
+	assert.Equal(t, "[transfer] not found", Message(English, "not_found", []any{"transfer"}))
+	assert.Equal(t, "перевод", Message(Russian, string(EntityTransfer)))
+	assert.Equal(t, "Товар приобретён", Message("de", "item_purchased"))
+	assert.Equal(t, "unknown_code", Message(English, "unknown_code"))
+}
+
+func TestCatalogsMatch(t *testing.T) {
+	verbs := regexp.MustCompile(`%[a-z]`)
+
+	for code, ru := range catalog[Russian] {
+		en, ok := catalog[English][code]
+		if !assert.Truef(t, ok, "code %s has no English message", code) {
+			continue
+		}
+
+		assert.Equalf(t, verbs.FindAllString(ru, -1), verbs.FindAllString(en, -1),
+			"code %s has different format verbs", code)
+	}
+
+	for code := range catalog[English] {
+		_, ok := catalog[Russian][code]
+		assert.Truef(t, ok, "code %s has no Russian message", code)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/models"
 	"github.com/gin-gonic/gin"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -13,6 +14,9 @@ type Metrics struct {
 	httpRequestTotal             *prometheus.CounterVec
 	httpRequestDurationHistogram *prometheus.HistogramVec
 	httpRequestsDurationSummary  *prometheus.SummaryVec
+
+	reconciliationDiscrepancies *prometheus.GaugeVec
+	reconciliationLastRun       prometheus.Gauge
 }
 
 func New() *Metrics {
@@ -51,10 +55,27 @@ func New() *Metrics {
 		[]string{"path"},
 	)
 
+	reconciliationDiscrepancies := promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "balance_reconciliation_difference_coins",
+			Help: "Difference between the stored and the expected balance by user, only for mismatched users",
+		},
+		[]string{"username"},
+	)
+
+	reconciliationLastRun := promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "balance_reconciliation_last_run_timestamp_seconds",
+			Help: "Unix time of the last balance reconciliation",
+		},
+	)
+
 	return &Metrics{
 		httpRequestTotal:             httpRequestTotal,
 		httpRequestDurationHistogram: httpRequestDurationHistogram,
 		httpRequestsDurationSummary:  httpRequestsDurationSummary,
+		reconciliationDiscrepancies:  reconciliationDiscrepancies,
+		reconciliationLastRun:        reconciliationLastRun,
 	}
 }
 
@@ -72,3 +93,15 @@ func (hdl *Metrics) HTTPMetrics() gin.HandlerFunc {
 		hdl.httpRequestDurationHistogram.WithLabelValues(path).Observe(duration)
 	}
 }
+
+// ObserveReconciliation публикует результат сверки балансов.
+// Расхождения прошлой сверки, которые исчезли, удаляются.
+func (hdl *Metrics) ObserveReconciliation(report models.ReconciliationReport) {
+	hdl.reconciliationDiscrepancies.Reset()
+
+	for _, discrepancy := range report.Discrepancies {
+		hdl.reconciliationDiscrepancies.WithLabelValues(discrepancy.Username).Set(float64(discrepancy.Difference))
+	}
+
+	hdl.reconciliationLastRun.Set(float64(report.CheckedAt.Unix()))
+}
@@ -1,10 +1,13 @@
 package models
 
+import "time"
+
 type User struct {
 	Id       int    `json:"id"`
 	Username string `json:"username"`
 	Password string `json:"password_hash"`
 	Coins    int    `json:"coins"`
+	Role     string `json:"role"`
 }
 
 type AuthReq struct {
@@ -18,11 +21,297 @@ type Items struct {
 }
 
 type ReceivedCoins struct {
-	FromUser string `json:"f"`
-	Amount   int    `json:"amount"`
+	FromUser string   `json:"f"`
+	Amount   int      `json:"amount"`
+	Messages []string `json:"messages"`
 }
 
 type SentCoins struct {
 	ToUser string `json:"to_user"`
 	Amount int    `json:"amount"`
+	Status string `json:"status"`
+}
+
+type TransferRecipient struct {
+	ToUser string `json:"to_user"`
+	Amount int    `json:"amount"`
+}
+
+const (
+	PurchaseStatusCompleted = "completed"
+	PurchaseStatusRefunded  = "refunded"
+)
+
+type Purchase struct {
+	Id                 int    `json:"id"`
+	ProductId          int    `json:"products_id"`
+	Quantity           int    `json:"quantity"`
+	TotalPrice         int    `json:"total_price"`
+	Status             string `json:"status"`
+	WithinRefundWindow bool   `json:"within_refund_window"`
+}
+
+type PurchaseRecord struct {
+	Id          int       `json:"id"`
+	Name        string    `json:"name"`
+	Quantity    int       `json:"quantity"`
+	UnitPrice   int       `json:"unit_price"`
+	TotalPrice  int       `json:"total_price"`
+	Status      string    `json:"status"`
+	PurchasedAt time.Time `json:"purchased_at"`
+}
+
+type PurchasesFilter struct {
+	Limit  int
+	Offset int
+}
+
+const (
+	TransactionDirectionSent     = "sent"
+	TransactionDirectionReceived = "received"
+)
+
+const (
+	TransferStatusCompleted = "completed"
+	TransferStatusPending   = "pending"
+	TransferStatusDeclined  = "declined"
+	TransferStatusExpired   = "expired"
+	TransferStatusReversed  = "reversed"
+	TransferStatusReversal  = "reversal"
+)
+
+type Transaction struct {
+	Id           int        `json:"id"`
+	Direction    string     `json:"direction"`
+	Counterparty string     `json:"counterparty"`
+	Amount       int        `json:"amount"`
+	Message      *string    `json:"message"`
+	Status       string     `json:"status"`
+	ExpiresAt    *time.Time `json:"expires_at"`
+	ReversalOf   *int       `json:"reversal_of"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+type TransactionsFilter struct {
+	BeforeId     int
+	Limit        int
+	From         *time.Time
+	To           *time.Time
+	Counterparty string
+	Status       string
+}
+
+type PendingTransfer struct {
+	Id         int    `json:"id"`
+	SenderId   int    `json:"sender_id"`
+	ReceiverId int    `json:"receiver_id"`
+	Amount     int    `json:"amount"`
+	Status     string `json:"status"`
+	Expired    bool   `json:"expired"`
+}
+
+const (
+	PaymentRequestStatusPending  = "pending"
+	PaymentRequestStatusApproved = "approved"
+	PaymentRequestStatusRejected = "rejected"
+	PaymentRequestStatusReversed = "reversed"
+)
+
+type PaymentRequest struct {
+	Id            int       `json:"id"`
+	RequesterId   int       `json:"requester_id"`
+	Requester     string    `json:"requester"`
+	PayerId       int       `json:"payer_id"`
+	Payer         string    `json:"payer"`
+	Amount        int       `json:"amount"`
+	Reason        *string   `json:"reason"`
+	Status        string    `json:"status"`
+	TransactionId *int      `json:"transaction_id"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type PaymentRequestsFilter struct {
+	Status string
+	Limit  int
+	Offset int
+}
+
+const (
+	OperationBuyItem   = "buy_item"
+	OperationSendCoins = "send_coins"
+
+	OperationSendCoinsBatch = "send_coins_batch"
+)
+
+type IdempotencyKey struct {
+	UserId      int
+	Key         string
+	Operation   string
+	Request     string
+	ResourceId  int
+	ResourceIds []int
+}
+
+const (
+	LedgerAccountUser     = "user"
+	LedgerAccountIssuance = "issuance"
+	LedgerAccountShop     = "shop"
+	LedgerAccountEscrow   = "escrow"
+	LedgerAccountExpired  = "expired"
+)
+
+const (
+	JournalOpening  = "opening"
+	JournalGrant    = "grant"
+	JournalPurchase = "purchase"
+	JournalTransfer = "transfer"
+	JournalRefund   = "refund"
+
+	JournalTransferHold    = "transfer_hold"
+	JournalTransferAccept  = "transfer_accept"
+	JournalTransferRelease = "transfer_release"
+
+	JournalIssuance = "bulk_issuance"
+
+	JournalCoinExpiration = "coin_expiration"
+
+	JournalTransferReversal = "transfer_reversal"
+)
+
+type Transfer struct {
+	Id         int    `json:"id"`
+	SenderId   int    `json:"sender_id"`
+	Sender     string `json:"sender"`
+	ReceiverId int    `json:"receiver_id"`
+	Receiver   string `json:"receiver"`
+	Amount     int    `json:"amount"`
+	Status     string `json:"status"`
+}
+
+type TransferReversal struct {
+	Id            int       `json:"id"`
+	TransactionId int       `json:"transaction_id"`
+	ReversalId    int       `json:"reversal_id"`
+	ReversedBy    string    `json:"reversed_by"`
+	Reason        string    `json:"reason"`
+	Forced        bool      `json:"forced"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type CoinLot struct {
+	Id        int       `json:"id"`
+	UserId    int       `json:"user_id"`
+	Amount    int       `json:"amount"`
+	Remaining int       `json:"remaining"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// CoinLotSpend - часть партии монет, израсходованная одним списанием.
+type CoinLotSpend struct {
+	LotId     int       `json:"lot_id"`
+	Amount    int       `json:"amount"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type Journal struct {
+	Operation   string
+	ReferenceId int
+	Entries     []LedgerEntry
+}
+
+type LedgerEntry struct {
+	Account string
+	UserId  *int
+	Amount  int
+}
+
+type UserBalance struct {
+	UserId   int    `json:"user_id"`
+	Username string `json:"username"`
+	Coins    int    `json:"coins"`
+	Expected int    `json:"expected"`
+}
+
+type BalanceDiscrepancy struct {
+	UserId     int    `json:"user_id"`
+	Username   string `json:"username"`
+	Coins      int    `json:"coins"`
+	Expected   int    `json:"expected"`
+	Difference int    `json:"difference"`
+}
+
+type ReconciliationReport struct {
+	CheckedAt     time.Time            `json:"checked_at"`
+	UsersChecked  int                  `json:"users_checked"`
+	Discrepancies []BalanceDiscrepancy `json:"discrepancies"`
+}
+
+// TransferLimits содержит действующие лимиты переводов пользователя.
+// Ноль означает, что лимит не ограничен.
+type TransferLimits struct {
+	MaxAmount   int `json:"max_amount"`
+	DailyLimit  int `json:"daily_limit"`
+	WeeklyLimit int `json:"weekly_limit"`
+	HourlyCount int `json:"hourly_count"`
+}
+
+// TransferLimitsOverride содержит лимиты, заданные пользователю администратором.
+// Незаданный лимит берётся из конфигурации.
+type TransferLimitsOverride struct {
+	MaxAmount   *int `json:"max_amount"`
+	DailyLimit  *int `json:"daily_limit"`
+	WeeklyLimit *int `json:"weekly_limit"`
+	HourlyCount *int `json:"hourly_count"`
+}
+
+// TransferStats содержит сумму и количество недавних переводов отправителя.
+type TransferStats struct {
+	Daily       int `json:"daily"`
+	Weekly      int `json:"weekly"`
+	HourlyCount int `json:"hourly_count"`
+}
+
+type IssuanceRow struct {
+	Line     int    `json:"line"`
+	Username string `json:"username"`
+	Amount   int    `json:"amount"`
+	Reason   string `json:"reason"`
+	Error    error  `json:"-"`
+}
+
+type IssuanceReport struct {
+	DryRun  bool          `json:"dry_run"`
+	Applied bool          `json:"applied"`
+	Total   int           `json:"total"`
+	Errors  int           `json:"errors"`
+	Rows    []IssuanceRow `json:"rows"`
+}
+
+type CartItem struct {
+	ProductId int    `json:"products_id"`
+	Name      string `json:"name"`
+	Quantity  int    `json:"quantity"`
+	Price     int    `json:"price"`
+}
+
+type Product struct {
+	Name      string `json:"name"`
+	Price     int    `json:"price"`
+	Available bool   `json:"available"`
+	Stock     *int   `json:"stock,omitempty"`
+}
+
+type ProductUpdate struct {
+	Name           *string
+	Price          *int
+	Available      *bool
+	Stock          *int
+	UnlimitedStock bool
+}
+
+type ProductsFilter struct {
+	SortBy string
+	Order  string
+	Limit  int
+	Offset int
 }
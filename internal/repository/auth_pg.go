@@ -2,14 +2,15 @@ package repository
 
 import (
 	"context"
-	"strings"
+	"errors"
 
 	db "github.com/MaksimovDenis/Avito_merch_shop/internal/client"
+	errresponse "github.com/MaksimovDenis/Avito_merch_shop/internal/err_response"
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/i18n"
 	"github.com/MaksimovDenis/Avito_merch_shop/internal/models"
 	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
 	"github.com/rs/zerolog"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 type Authorization interface {
@@ -29,14 +30,40 @@ func newAuthRepository(db db.Client, log zerolog.Logger) *AuthRepo {
 	}
 }
 
+// grantLedgerQuery записывает в журнал проводок стартовый баланс,
+// выданный новому пользователю значением по умолчанию столбца users.coins,
+// и создаёт для него первую партию монет.
+const grantLedgerQuery = `,
+grant_journal AS (
+	INSERT INTO ledger_journals (operation, reference_id)
+	SELECT '` + models.JournalGrant + `', id FROM new_user WHERE coins <> 0
+	RETURNING id, reference_id
+),
+grant_entries AS (
+	INSERT INTO ledger_entries (journal_id, account, user_id, amount)
+	SELECT j.id, '` + models.LedgerAccountUser + `', u.id, u.coins
+	FROM grant_journal j JOIN new_user u ON u.id = j.reference_id
+	UNION ALL
+	SELECT j.id, '` + models.LedgerAccountIssuance + `', NULL, -u.coins
+	FROM grant_journal j JOIN new_user u ON u.id = j.reference_id
+),
+grant_lot AS (
+	INSERT INTO coin_lots (user_id, amount, remaining)
+	SELECT id, coins, coins FROM new_user WHERE coins > 0
+)
+SELECT id, username, coins, role FROM new_user`
+
+// CreateUser создаёт пользователя и одним запросом записывает
+// выданный ему стартовый баланс в журнал проводок.
 func (arp *AuthRepo) CreateUser(ctx context.Context, user models.AuthReq) (models.User, error) {
 	var res models.User
 
 	builder := squirrel.Insert("users").
 		PlaceholderFormat(squirrel.Dollar).
+		Prefix("WITH new_user AS (").
 		Columns("username", "password_hash").
 		Values(user.Username, user.Password).
-		Suffix("RETURNING id, username, coins")
+		Suffix("RETURNING id, username, coins, role)" + grantLedgerQuery)
 
 	query, args, err := builder.ToSql()
 	if err != nil {
@@ -50,10 +77,10 @@ func (arp *AuthRepo) CreateUser(ctx context.Context, user models.AuthReq) (model
 	}
 
 	err = arp.db.DB().QueryRowContext(ctx, queryStruct, args...).
-		Scan(&res.Id, &res.Username, &res.Coins)
+		Scan(&res.Id, &res.Username, &res.Coins, &res.Role)
 	if err != nil {
 		arp.log.Error().Err(err).Msg("CreateUser: failed to execute query")
-		return res, status.Errorf(codes.Internal, "failed to create user: %v", err)
+		return res, errresponse.ErrResponse(err)
 	}
 
 	return res, nil
@@ -62,7 +89,7 @@ func (arp *AuthRepo) CreateUser(ctx context.Context, user models.AuthReq) (model
 func (arp *AuthRepo) GetUser(ctx context.Context, username string) (models.User, error) {
 	var res models.User
 
-	builder := squirrel.Select("*").
+	builder := squirrel.Select("id", "username", "password_hash", "coins", "role").
 		PlaceholderFormat(squirrel.Dollar).
 		From("users").
 		Where(squirrel.Eq{"username": username})
@@ -79,15 +106,15 @@ func (arp *AuthRepo) GetUser(ctx context.Context, username string) (models.User,
 	}
 
 	err = arp.db.DB().QueryRowContext(ctx, queryStruct, args...).
-		Scan(&res.Id, &res.Username, &res.Password, &res.Coins)
-	if err != nil && strings.Contains(err.Error(), "no rows in result set") {
+		Scan(&res.Id, &res.Username, &res.Password, &res.Coins, &res.Role)
+	if errors.Is(err, pgx.ErrNoRows) {
 		arp.log.Warn().Str("username", username).Msg("GetUser: user not found")
 
-		return res, status.Errorf(codes.NotFound, "User not found")
+		return res, errresponse.ErrResponse(err, i18n.EntityUser)
 	} else if err != nil {
 		arp.log.Error().Err(err).Msg("GetUser: failed to execute query")
 
-		return res, status.Errorf(codes.Internal, "Internal server error")
+		return res, errresponse.ErrResponse(err)
 	}
 
 	return res, nil
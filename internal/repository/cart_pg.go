@@ -0,0 +1,186 @@
+package repository
+
+import (
+	"context"
+
+	db "github.com/MaksimovDenis/Avito_merch_shop/internal/client"
+	errresponse "github.com/MaksimovDenis/Avito_merch_shop/internal/err_response"
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/models"
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"github.com/rs/zerolog"
+)
+
+type Cart interface {
+	AddCartItem(ctx context.Context, userId int, productName string, quantity int) error
+	RemoveCartItem(ctx context.Context, userId int, productName string) error
+	GetCartItems(ctx context.Context, userId int) ([]models.CartItem, error)
+	LockCartItems(ctx context.Context, userId int) ([]models.CartItem, error)
+	RemoveCartItems(ctx context.Context, userId int, productIds []int) error
+}
+
+type CartRepo struct {
+	db  db.Client
+	log zerolog.Logger
+}
+
+func newCartRepository(db db.Client, log zerolog.Logger) *CartRepo {
+	return &CartRepo{
+		db:  db,
+		log: log,
+	}
+}
+
+func (crp *CartRepo) AddCartItem(ctx context.Context, userId int, productName string, quantity int) error {
+	selectProduct := squirrel.Select().
+		Column("?::int", userId).
+		Column("id").
+		Column("?::int", quantity).
+		From("products").
+		Where(squirrel.Eq{"name": productName, "available": true})
+
+	insertQuery := squirrel.Insert("cart_items").PlaceholderFormat(squirrel.Dollar).
+		Columns("user_id", "products_id", "quantity").
+		Select(selectProduct).
+		Suffix("ON CONFLICT (user_id, products_id) DO UPDATE SET quantity = cart_items.quantity + EXCLUDED.quantity")
+
+	query, args, err := insertQuery.ToSql()
+	if err != nil {
+		crp.log.Error().Err(err).Msg("AddCartItem: failed to build insert SQL query")
+		return errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "cart_repository.AddCartItem",
+		QueryRow: query,
+	}
+
+	tag, err := crp.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		crp.log.Error().Err(err).Msg("AddCartItem: failed to add item to cart")
+		return errresponse.ErrResponse(err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		crp.log.Warn().Str("product", productName).Msg("AddCartItem: product not found")
+		return errresponse.ErrResponse(pgx.ErrNoRows, productName)
+	}
+
+	return nil
+}
+
+func (crp *CartRepo) RemoveCartItem(ctx context.Context, userId int, productName string) error {
+	deleteQuery := squirrel.Delete("cart_items").PlaceholderFormat(squirrel.Dollar).
+		Suffix("USING products WHERE products.id = cart_items.products_id "+
+			"AND cart_items.user_id = ? AND products.name = ?", userId, productName)
+
+	query, args, err := deleteQuery.ToSql()
+	if err != nil {
+		crp.log.Error().Err(err).Msg("RemoveCartItem: failed to build delete SQL query")
+		return errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "cart_repository.RemoveCartItem",
+		QueryRow: query,
+	}
+
+	tag, err := crp.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		crp.log.Error().Err(err).Msg("RemoveCartItem: failed to remove item from cart")
+		return errresponse.ErrResponse(err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		crp.log.Warn().Str("product", productName).Msg("RemoveCartItem: item not found in cart")
+		return errresponse.ErrResponse(pgx.ErrNoRows, productName)
+	}
+
+	return nil
+}
+
+func (crp *CartRepo) GetCartItems(ctx context.Context, userId int) ([]models.CartItem, error) {
+	var items []models.CartItem
+
+	builder := squirrel.Select("p.name AS name", "c.quantity AS quantity", "p.price AS price").
+		PlaceholderFormat(squirrel.Dollar).
+		From("cart_items c").
+		Join("products p ON p.id = c.products_id").
+		Where(squirrel.Eq{"c.user_id": userId}).
+		OrderBy("p.name")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		crp.log.Error().Err(err).Msg("GetCartItems: failed to build select SQL query")
+		return nil, errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "cart_repository.GetCartItems",
+		QueryRow: query,
+	}
+
+	err = crp.db.DB().ScanAllContext(ctx, &items, queryStruct, args...)
+	if err != nil {
+		crp.log.Error().Err(err).Msg("GetCartItems: failed to scan rows")
+		return nil, errresponse.ErrResponse(err)
+	}
+
+	return items, nil
+}
+
+func (crp *CartRepo) LockCartItems(ctx context.Context, userId int) ([]models.CartItem, error) {
+	var items []models.CartItem
+
+	builder := squirrel.Select("c.products_id AS product_id", "p.name AS name", "c.quantity AS quantity",
+		"p.price AS price").
+		PlaceholderFormat(squirrel.Dollar).
+		From("cart_items c").
+		Join("products p ON p.id = c.products_id").
+		Where(squirrel.Eq{"c.user_id": userId}).
+		OrderBy("p.name").
+		Suffix("FOR UPDATE OF c")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		crp.log.Error().Err(err).Msg("LockCartItems: failed to build select SQL query")
+		return nil, errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "cart_repository.LockCartItems",
+		QueryRow: query,
+	}
+
+	err = crp.db.DB().ScanAllContext(ctx, &items, queryStruct, args...)
+	if err != nil {
+		crp.log.Error().Err(err).Msg("LockCartItems: failed to lock rows")
+		return nil, errresponse.ErrResponse(err)
+	}
+
+	return items, nil
+}
+
+func (crp *CartRepo) RemoveCartItems(ctx context.Context, userId int, productIds []int) error {
+	deleteQuery := squirrel.Delete("cart_items").PlaceholderFormat(squirrel.Dollar).
+		Where(squirrel.Eq{"user_id": userId, "products_id": productIds})
+
+	query, args, err := deleteQuery.ToSql()
+	if err != nil {
+		crp.log.Error().Err(err).Msg("RemoveCartItems: failed to build delete SQL query")
+		return errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "cart_repository.RemoveCartItems",
+		QueryRow: query,
+	}
+
+	_, err = crp.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		crp.log.Error().Err(err).Msg("RemoveCartItems: failed to remove items from cart")
+		return errresponse.ErrResponse(err)
+	}
+
+	return nil
+}
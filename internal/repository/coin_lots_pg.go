@@ -0,0 +1,367 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	db "github.com/MaksimovDenis/Avito_merch_shop/internal/client"
+	errresponse "github.com/MaksimovDenis/Avito_merch_shop/internal/err_response"
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/models"
+	"github.com/Masterminds/squirrel"
+	"github.com/rs/zerolog"
+)
+
+type CoinLots interface {
+	ExpiringCoinLots(ctx context.Context, userId int, within time.Duration) ([]models.CoinLot, error)
+	ExpiredCoinLotIds(ctx context.Context, limit int) ([]int, error)
+	WriteOffCoinLot(ctx context.Context, lotId int) (models.CoinLot, error)
+	WriteOffExpiredCoinLots(ctx context.Context, userId int) ([]models.CoinLot, error)
+	AddPurchaseSpends(ctx context.Context, purchaseId int, spends []models.CoinLotSpend) error
+	AddTransferSpends(ctx context.Context, transactionId int, spends []models.CoinLotSpend) error
+	PurchaseSpends(ctx context.Context, purchaseId int) ([]models.CoinLotSpend, error)
+	TransferSpends(ctx context.Context, transactionId int) ([]models.CoinLotSpend, error)
+}
+
+type CoinLotsRepo struct {
+	db  db.Client
+	log zerolog.Logger
+}
+
+func newCoinLotsRepository(db db.Client, log zerolog.Logger) *CoinLotsRepo {
+	return &CoinLotsRepo{
+		db:  db,
+		log: log,
+	}
+}
+
+// ExpiringCoinLots возвращает непотраченные партии пользователя,
+// срок действия которых истекает в течение заданного периода.
+// Уже просроченные партии не возвращаются: их монеты недоступны.
+func (clr *CoinLotsRepo) ExpiringCoinLots(ctx context.Context, userId int, within time.Duration) (
+	[]models.CoinLot, error) {
+	var lots []models.CoinLot
+
+	selectQuery := squirrel.Select("id", "user_id", "amount", "remaining", "expires_at").
+		PlaceholderFormat(squirrel.Dollar).
+		From("coin_lots").
+		Where(squirrel.Eq{"user_id": userId}).
+		Where("remaining > 0").
+		Where("expires_at > NOW()").
+		Where("expires_at <= NOW() + ? * INTERVAL '1 second'", within.Seconds()).
+		OrderBy("expires_at", "id")
+
+	query, args, err := selectQuery.ToSql()
+	if err != nil {
+		clr.log.Error().Err(err).Msg("ExpiringCoinLots: failed to build select SQL query")
+		return nil, errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "coin_lots_repository.ExpiringCoinLots",
+		QueryRow: query,
+	}
+
+	err = clr.db.DB().ScanAllContext(ctx, &lots, queryStruct, args...)
+	if err != nil {
+		clr.log.Error().Err(err).Msg("ExpiringCoinLots: failed to scan rows")
+		return nil, errresponse.ErrResponse(err)
+	}
+
+	return lots, nil
+}
+
+// ExpiredCoinLotIds возвращает партии с непотраченным остатком, срок действия которых истёк.
+func (clr *CoinLotsRepo) ExpiredCoinLotIds(ctx context.Context, limit int) ([]int, error) {
+	var ids []int
+
+	selectQuery := squirrel.Select("id").
+		PlaceholderFormat(squirrel.Dollar).
+		From("coin_lots").
+		Where("remaining > 0").
+		Where("expires_at <= NOW()").
+		OrderBy("expires_at", "id").
+		Limit(uint64(limit))
+
+	query, args, err := selectQuery.ToSql()
+	if err != nil {
+		clr.log.Error().Err(err).Msg("ExpiredCoinLotIds: failed to build select SQL query")
+		return nil, errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "coin_lots_repository.ExpiredCoinLotIds",
+		QueryRow: query,
+	}
+
+	err = clr.db.DB().ScanAllContext(ctx, &ids, queryStruct, args...)
+	if err != nil {
+		clr.log.Error().Err(err).Msg("ExpiredCoinLotIds: failed to scan rows")
+		return nil, errresponse.ErrResponse(err)
+	}
+
+	return ids, nil
+}
+
+// WriteOffCoinLot списывает остаток просроченной партии с баланса пользователя.
+// Сначала блокируется строка пользователя, как и при любом другом изменении
+// баланса, затем остаток партии проверяется повторно: партия могла быть
+// израсходована после выборки. Возвращает партию со списанным остатком;
+// нулевой остаток означает, что списывать было нечего.
+func (clr *CoinLotsRepo) WriteOffCoinLot(ctx context.Context, lotId int) (models.CoinLot, error) {
+	var (
+		lot  models.CoinLot
+		lots []models.CoinLot
+	)
+
+	lockQuery := squirrel.Select("u.id").
+		PlaceholderFormat(squirrel.Dollar).
+		From("users u").
+		Join("coin_lots l ON l.user_id = u.id").
+		Where(squirrel.Eq{"l.id": lotId}).
+		Suffix("FOR UPDATE OF u")
+
+	query, args, err := lockQuery.ToSql()
+	if err != nil {
+		clr.log.Error().Err(err).Msg("WriteOffCoinLot: failed to build select SQL query")
+		return lot, errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "coin_lots_repository.WriteOffCoinLot",
+		QueryRow: query,
+	}
+
+	err = clr.db.DB().QueryRowContext(ctx, queryStruct, args...).Scan(&lot.UserId)
+	if err != nil {
+		clr.log.Error().Err(err).Msg("WriteOffCoinLot: failed to lock user")
+		return lot, errresponse.ErrResponse(err)
+	}
+
+	updateQuery := squirrel.Update("coin_lots c").PlaceholderFormat(squirrel.Dollar).
+		Set("remaining", 0).
+		From("coin_lots old").
+		Where("c.id = old.id").
+		Where(squirrel.Eq{"c.id": lotId}).
+		Where("c.remaining > 0").
+		Where("c.expires_at <= NOW()").
+		Suffix("RETURNING c.id, c.user_id, c.amount, old.remaining, c.expires_at")
+
+	query, args, err = updateQuery.ToSql()
+	if err != nil {
+		clr.log.Error().Err(err).Msg("WriteOffCoinLot: failed to build update SQL query")
+		return lot, errresponse.ErrResponse(err)
+	}
+
+	queryStruct = db.Query{
+		Name:     "coin_lots_repository.WriteOffCoinLot",
+		QueryRow: query,
+	}
+
+	err = clr.db.DB().ScanAllContext(ctx, &lots, queryStruct, args...)
+	if err != nil {
+		clr.log.Error().Err(err).Msg("WriteOffCoinLot: failed to write off coin lot")
+		return lot, errresponse.ErrResponse(err)
+	}
+
+	if len(lots) == 0 {
+		return lot, nil
+	}
+
+	lot = lots[0]
+
+	updateQuery = squirrel.Update("users").PlaceholderFormat(squirrel.Dollar).
+		Set("coins", squirrel.Expr("coins - ?", lot.Remaining)).
+		Where(squirrel.Eq{"id": lot.UserId})
+
+	query, args, err = updateQuery.ToSql()
+	if err != nil {
+		clr.log.Error().Err(err).Msg("WriteOffCoinLot: failed to build update SQL query")
+		return lot, errresponse.ErrResponse(err)
+	}
+
+	queryStruct = db.Query{
+		Name:     "coin_lots_repository.WriteOffCoinLot",
+		QueryRow: query,
+	}
+
+	_, err = clr.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		clr.log.Error().Err(err).Msg("WriteOffCoinLot: failed to update user balance")
+		return lot, errresponse.ErrResponse(err)
+	}
+
+	return lot, nil
+}
+
+// WriteOffExpiredCoinLots списывает остатки всех просроченных партий пользователя
+// с его баланса. Вызывается перед списанием монет, чтобы покупки и переводы
+// не расходовали сгоревшие монеты, не дожидаясь фоновой задачи. Строка
+// пользователя блокируется так же, как в WriteOffCoinLot. Возвращает партии
+// со списанными остатками.
+func (clr *CoinLotsRepo) WriteOffExpiredCoinLots(ctx context.Context, userId int) ([]models.CoinLot, error) {
+	var lots []models.CoinLot
+
+	lockQuery := squirrel.Select("id").
+		PlaceholderFormat(squirrel.Dollar).
+		From("users").
+		Where(squirrel.Eq{"id": userId}).
+		Suffix("FOR UPDATE")
+
+	query, args, err := lockQuery.ToSql()
+	if err != nil {
+		clr.log.Error().Err(err).Msg("WriteOffExpiredCoinLots: failed to build select SQL query")
+		return nil, errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "coin_lots_repository.WriteOffExpiredCoinLots",
+		QueryRow: query,
+	}
+
+	err = clr.db.DB().QueryRowContext(ctx, queryStruct, args...).Scan(&userId)
+	if err != nil {
+		clr.log.Error().Err(err).Msg("WriteOffExpiredCoinLots: failed to lock user")
+		return nil, errresponse.ErrResponse(err)
+	}
+
+	updateQuery := squirrel.Update("coin_lots c").PlaceholderFormat(squirrel.Dollar).
+		Set("remaining", 0).
+		From("coin_lots old").
+		Where("c.id = old.id").
+		Where(squirrel.Eq{"c.user_id": userId}).
+		Where("c.remaining > 0").
+		Where("c.expires_at <= NOW()").
+		Suffix("RETURNING c.id, c.user_id, c.amount, old.remaining, c.expires_at")
+
+	query, args, err = updateQuery.ToSql()
+	if err != nil {
+		clr.log.Error().Err(err).Msg("WriteOffExpiredCoinLots: failed to build update SQL query")
+		return nil, errresponse.ErrResponse(err)
+	}
+
+	queryStruct = db.Query{
+		Name:     "coin_lots_repository.WriteOffExpiredCoinLots",
+		QueryRow: query,
+	}
+
+	err = clr.db.DB().ScanAllContext(ctx, &lots, queryStruct, args...)
+	if err != nil {
+		clr.log.Error().Err(err).Msg("WriteOffExpiredCoinLots: failed to write off coin lots")
+		return nil, errresponse.ErrResponse(err)
+	}
+
+	total := 0
+	for _, lot := range lots {
+		total += lot.Remaining
+	}
+
+	if total == 0 {
+		return lots, nil
+	}
+
+	updateQuery = squirrel.Update("users").PlaceholderFormat(squirrel.Dollar).
+		Set("coins", squirrel.Expr("coins - ?", total)).
+		Where(squirrel.Eq{"id": userId})
+
+	query, args, err = updateQuery.ToSql()
+	if err != nil {
+		clr.log.Error().Err(err).Msg("WriteOffExpiredCoinLots: failed to build update SQL query")
+		return nil, errresponse.ErrResponse(err)
+	}
+
+	queryStruct = db.Query{
+		Name:     "coin_lots_repository.WriteOffExpiredCoinLots",
+		QueryRow: query,
+	}
+
+	_, err = clr.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		clr.log.Error().Err(err).Msg("WriteOffExpiredCoinLots: failed to update user balance")
+		return nil, errresponse.ErrResponse(err)
+	}
+
+	return lots, nil
+}
+
+// AddPurchaseSpends сохраняет части партий, израсходованные покупкой.
+func (clr *CoinLotsRepo) AddPurchaseSpends(ctx context.Context, purchaseId int, spends []models.CoinLotSpend) error {
+	return clr.addSpends(ctx, "purchase_id", purchaseId, spends)
+}
+
+// AddTransferSpends сохраняет части партий отправителя, израсходованные переводом.
+func (clr *CoinLotsRepo) AddTransferSpends(ctx context.Context, transactionId int,
+	spends []models.CoinLotSpend) error {
+	return clr.addSpends(ctx, "transaction_id", transactionId, spends)
+}
+
+// PurchaseSpends возвращает части партий, израсходованные покупкой.
+func (clr *CoinLotsRepo) PurchaseSpends(ctx context.Context, purchaseId int) ([]models.CoinLotSpend, error) {
+	return clr.spends(ctx, "purchase_id", purchaseId)
+}
+
+// TransferSpends возвращает части партий отправителя, израсходованные переводом.
+func (clr *CoinLotsRepo) TransferSpends(ctx context.Context, transactionId int) ([]models.CoinLotSpend, error) {
+	return clr.spends(ctx, "transaction_id", transactionId)
+}
+
+func (clr *CoinLotsRepo) addSpends(ctx context.Context, column string, id int, spends []models.CoinLotSpend) error {
+	if len(spends) == 0 {
+		return nil
+	}
+
+	insertQuery := squirrel.Insert("coin_lot_spends").PlaceholderFormat(squirrel.Dollar).
+		Columns("lot_id", "amount", column)
+
+	for _, spend := range spends {
+		insertQuery = insertQuery.Values(spend.LotId, spend.Amount, id)
+	}
+
+	query, args, err := insertQuery.ToSql()
+	if err != nil {
+		clr.log.Error().Err(err).Msg("addSpends: failed to build insert SQL query")
+		return errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "coin_lots_repository.addSpends",
+		QueryRow: query,
+	}
+
+	_, err = clr.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		clr.log.Error().Err(err).Msg("addSpends: failed to insert coin lot spends")
+		return errresponse.ErrResponse(err)
+	}
+
+	return nil
+}
+
+func (clr *CoinLotsRepo) spends(ctx context.Context, column string, id int) ([]models.CoinLotSpend, error) {
+	var spends []models.CoinLotSpend
+
+	selectQuery := squirrel.Select("s.lot_id", "s.amount", "l.expires_at").
+		PlaceholderFormat(squirrel.Dollar).
+		From("coin_lot_spends s").
+		Join("coin_lots l ON l.id = s.lot_id").
+		Where(squirrel.Eq{"s." + column: id}).
+		OrderBy("l.expires_at", "s.lot_id")
+
+	query, args, err := selectQuery.ToSql()
+	if err != nil {
+		clr.log.Error().Err(err).Msg("spends: failed to build select SQL query")
+		return nil, errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "coin_lots_repository.spends",
+		QueryRow: query,
+	}
+
+	err = clr.db.DB().ScanAllContext(ctx, &spends, queryStruct, args...)
+	if err != nil {
+		clr.log.Error().Err(err).Msg("spends: failed to scan rows")
+		return nil, errresponse.ErrResponse(err)
+	}
+
+	return spends, nil
+}
@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"context"
+
+	db "github.com/MaksimovDenis/Avito_merch_shop/internal/client"
+	errresponse "github.com/MaksimovDenis/Avito_merch_shop/internal/err_response"
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/i18n"
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/models"
+	"github.com/Masterminds/squirrel"
+	"github.com/rs/zerolog"
+)
+
+type Idempotency interface {
+	SaveIdempotencyKey(ctx context.Context, key models.IdempotencyKey) (saved bool, err error)
+	GetIdempotencyKey(ctx context.Context, userId int, key string) (models.IdempotencyKey, error)
+	SetIdempotencyKeyResource(ctx context.Context, userId int, key string, resourceId int) error
+	SetIdempotencyKeyResources(ctx context.Context, userId int, key string, resourceIds []int) error
+}
+
+type IdempotencyRepo struct {
+	db  db.Client
+	log zerolog.Logger
+}
+
+func newIdempotencyRepository(db db.Client, log zerolog.Logger) *IdempotencyRepo {
+	return &IdempotencyRepo{
+		db:  db,
+		log: log,
+	}
+}
+
+// SaveIdempotencyKey сохраняет ключ идемпотентности. Если ключ уже сохранён
+// в параллельной транзакции, запрос дожидается её завершения.
+// Возвращает false, если такой ключ уже существует.
+func (irp *IdempotencyRepo) SaveIdempotencyKey(ctx context.Context, key models.IdempotencyKey) (bool, error) {
+	insertQuery := squirrel.Insert("idempotency_keys").PlaceholderFormat(squirrel.Dollar).
+		Columns("user_id", "key", "operation", "request").
+		Values(key.UserId, key.Key, key.Operation, key.Request).
+		Suffix("ON CONFLICT (user_id, key) DO NOTHING")
+
+	query, args, err := insertQuery.ToSql()
+	if err != nil {
+		irp.log.Error().Err(err).Msg("SaveIdempotencyKey: failed to build insert SQL query")
+		return false, errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "idempotency_repository.SaveIdempotencyKey",
+		QueryRow: query,
+	}
+
+	tag, err := irp.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		irp.log.Error().Err(err).Msg("SaveIdempotencyKey: failed to insert key")
+		return false, errresponse.ErrResponse(err)
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+func (irp *IdempotencyRepo) GetIdempotencyKey(ctx context.Context, userId int, key string) (
+	models.IdempotencyKey, error) {
+	res := models.IdempotencyKey{
+		UserId: userId,
+		Key:    key,
+	}
+
+	selectQuery := squirrel.Select("operation", "request", "COALESCE(resource_id, 0)",
+		"COALESCE(resource_ids, '{}')").
+		PlaceholderFormat(squirrel.Dollar).
+		From("idempotency_keys").
+		Where(squirrel.Eq{"user_id": userId, "key": key})
+
+	query, args, err := selectQuery.ToSql()
+	if err != nil {
+		irp.log.Error().Err(err).Msg("GetIdempotencyKey: failed to build select SQL query")
+		return models.IdempotencyKey{}, errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "idempotency_repository.GetIdempotencyKey",
+		QueryRow: query,
+	}
+
+	err = irp.db.DB().QueryRowContext(ctx, queryStruct, args...).Scan(&res.Operation, &res.Request, &res.ResourceId,
+		&res.ResourceIds)
+	if err != nil {
+		irp.log.Error().Err(err).Msg("GetIdempotencyKey: failed to get key")
+		return models.IdempotencyKey{}, errresponse.ErrResponse(err, i18n.EntityIdempotencyKey)
+	}
+
+	return res, nil
+}
+
+// SetIdempotencyKeyResource связывает ключ идемпотентности с ресурсом,
+// созданным запросом: покупкой или переводом.
+func (irp *IdempotencyRepo) SetIdempotencyKeyResource(ctx context.Context, userId int, key string,
+	resourceId int) error {
+	updateQuery := squirrel.Update("idempotency_keys").PlaceholderFormat(squirrel.Dollar).
+		Set("resource_id", resourceId).
+		Where(squirrel.Eq{"user_id": userId, "key": key})
+
+	query, args, err := updateQuery.ToSql()
+	if err != nil {
+		irp.log.Error().Err(err).Msg("SetIdempotencyKeyResource: failed to build update SQL query")
+		return errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "idempotency_repository.SetIdempotencyKeyResource",
+		QueryRow: query,
+	}
+
+	_, err = irp.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		irp.log.Error().Err(err).Msg("SetIdempotencyKeyResource: failed to update key")
+		return errresponse.ErrResponse(err)
+	}
+
+	return nil
+}
+
+// SetIdempotencyKeyResources связывает ключ идемпотентности с переводами,
+// созданными пакетным запросом.
+func (irp *IdempotencyRepo) SetIdempotencyKeyResources(ctx context.Context, userId int, key string,
+	resourceIds []int) error {
+	updateQuery := squirrel.Update("idempotency_keys").PlaceholderFormat(squirrel.Dollar).
+		Set("resource_ids", resourceIds).
+		Where(squirrel.Eq{"user_id": userId, "key": key})
+
+	query, args, err := updateQuery.ToSql()
+	if err != nil {
+		irp.log.Error().Err(err).Msg("SetIdempotencyKeyResources: failed to build update SQL query")
+		return errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "idempotency_repository.SetIdempotencyKeyResources",
+		QueryRow: query,
+	}
+
+	_, err = irp.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		irp.log.Error().Err(err).Msg("SetIdempotencyKeyResources: failed to update key")
+		return errresponse.ErrResponse(err)
+	}
+
+	return nil
+}
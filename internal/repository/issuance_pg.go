@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+
+	db "github.com/MaksimovDenis/Avito_merch_shop/internal/client"
+	errresponse "github.com/MaksimovDenis/Avito_merch_shop/internal/err_response"
+	"github.com/Masterminds/squirrel"
+	"github.com/rs/zerolog"
+)
+
+type Issuance interface {
+	InsertIssuance(ctx context.Context, userId int, amount int, reason string, issuedBy string) (
+		issuanceId int, err error)
+}
+
+type IssuanceRepo struct {
+	db  db.Client
+	log zerolog.Logger
+}
+
+func newIssuanceRepository(db db.Client, log zerolog.Logger) *IssuanceRepo {
+	return &IssuanceRepo{
+		db:  db,
+		log: log,
+	}
+}
+
+func (irp *IssuanceRepo) InsertIssuance(ctx context.Context, userId int, amount int, reason string,
+	issuedBy string) (issuanceId int, err error) {
+	var reasonValue *string
+	if reason != "" {
+		reasonValue = &reason
+	}
+
+	insertQuery := squirrel.Insert("coin_issuances").PlaceholderFormat(squirrel.Dollar).
+		Columns("user_id", "amount", "reason", "issued_by").
+		Values(userId, amount, reasonValue, issuedBy).
+		Suffix("RETURNING id")
+
+	query, args, err := insertQuery.ToSql()
+	if err != nil {
+		irp.log.Error().Err(err).Msg("InsertIssuance: failed to build insert SQL query")
+		return 0, errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "issuance_repository.InsertIssuance",
+		QueryRow: query,
+	}
+
+	err = irp.db.DB().QueryRowContext(ctx, queryStruct, args...).Scan(&issuanceId)
+	if err != nil {
+		irp.log.Error().Err(err).Msg("InsertIssuance: failed to insert issuance")
+		return 0, errresponse.ErrResponse(err)
+	}
+
+	return issuanceId, nil
+}
@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/MaksimovDenis/Avito_merch_shop/internal/client"
+	errresponse "github.com/MaksimovDenis/Avito_merch_shop/internal/err_response"
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/models"
+	"github.com/Masterminds/squirrel"
+	"github.com/rs/zerolog"
+)
+
+type Ledger interface {
+	PostJournal(ctx context.Context, journal models.Journal) error
+}
+
+type LedgerRepo struct {
+	db  db.Client
+	log zerolog.Logger
+}
+
+func newLedgerRepository(db db.Client, log zerolog.Logger) *LedgerRepo {
+	return &LedgerRepo{
+		db:  db,
+		log: log,
+	}
+}
+
+// PostJournal записывает операцию и её проводки в журнал.
+// Сумма проводок должна быть равна нулю, иначе операция отклоняется.
+// Нулевые проводки не записываются, операция без проводок пропускается.
+func (lrp *LedgerRepo) PostJournal(ctx context.Context, journal models.Journal) error {
+	var (
+		entries []models.LedgerEntry
+		sum     int
+	)
+
+	for _, entry := range journal.Entries {
+		if entry.Amount != 0 {
+			entries = append(entries, entry)
+			sum += entry.Amount
+		}
+	}
+
+	if len(entries) == 0 {
+		return nil
+	}
+
+	if sum != 0 {
+		lrp.log.Error().Str("operation", journal.Operation).Int("sum", sum).Msg("PostJournal: journal is not balanced")
+		return errors.New("операция не сбалансирована")
+	}
+
+	insertJournal := squirrel.Insert("ledger_journals").PlaceholderFormat(squirrel.Dollar).
+		Columns("operation", "reference_id").
+		Values(journal.Operation, journal.ReferenceId).
+		Suffix("RETURNING id")
+
+	query, args, err := insertJournal.ToSql()
+	if err != nil {
+		lrp.log.Error().Err(err).Msg("PostJournal: failed to build insert journal SQL query")
+		return errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "ledger_repository.PostJournal",
+		QueryRow: query,
+	}
+
+	var journalId int
+
+	err = lrp.db.DB().QueryRowContext(ctx, queryStruct, args...).Scan(&journalId)
+	if err != nil {
+		lrp.log.Error().Err(err).Msg("PostJournal: failed to insert journal")
+		return errresponse.ErrResponse(err)
+	}
+
+	insertEntries := squirrel.Insert("ledger_entries").PlaceholderFormat(squirrel.Dollar).
+		Columns("journal_id", "account", "user_id", "amount")
+
+	for _, entry := range entries {
+		insertEntries = insertEntries.Values(journalId, entry.Account, entry.UserId, entry.Amount)
+	}
+
+	query, args, err = insertEntries.ToSql()
+	if err != nil {
+		lrp.log.Error().Err(err).Msg("PostJournal: failed to build insert entries SQL query")
+		return errresponse.ErrResponse(err)
+	}
+
+	queryStruct = db.Query{
+		Name:     "ledger_repository.PostJournal",
+		QueryRow: query,
+	}
+
+	_, err = lrp.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		lrp.log.Error().Err(err).Msg("PostJournal: failed to insert entries")
+		return errresponse.ErrResponse(err)
+	}
+
+	return nil
+}
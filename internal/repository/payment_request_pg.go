@@ -0,0 +1,214 @@
+package repository
+
+import (
+	"context"
+
+	db "github.com/MaksimovDenis/Avito_merch_shop/internal/client"
+	errresponse "github.com/MaksimovDenis/Avito_merch_shop/internal/err_response"
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/i18n"
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/models"
+	"github.com/Masterminds/squirrel"
+	"github.com/rs/zerolog"
+)
+
+type PaymentRequest interface {
+	CreatePaymentRequest(ctx context.Context, requesterId int, payerId int, amount int, reason string) (
+		requestId int, err error)
+	GetPaymentRequestsByPayerId(ctx context.Context, payerId int, filter models.PaymentRequestsFilter) (
+		[]models.PaymentRequest, error)
+	GetPaymentRequest(ctx context.Context, requestId int) (models.PaymentRequest, error)
+	ResolvePaymentRequest(ctx context.Context, requestId int, status string, transactionId *int) error
+	MarkPaymentRequestReversed(ctx context.Context, transactionId int) error
+}
+
+type PaymentRequestRepo struct {
+	db  db.Client
+	log zerolog.Logger
+}
+
+func newPaymentRequestRepository(db db.Client, log zerolog.Logger) *PaymentRequestRepo {
+	return &PaymentRequestRepo{
+		db:  db,
+		log: log,
+	}
+}
+
+func (prp *PaymentRequestRepo) CreatePaymentRequest(ctx context.Context, requesterId int, payerId int, amount int,
+	reason string) (requestId int, err error) {
+	var reasonValue *string
+	if reason != "" {
+		reasonValue = &reason
+	}
+
+	insertQuery := squirrel.Insert("payment_requests").PlaceholderFormat(squirrel.Dollar).
+		Columns("requester_id", "payer_id", "amount", "reason").
+		Values(requesterId, payerId, amount, reasonValue).
+		Suffix("RETURNING id")
+
+	query, args, err := insertQuery.ToSql()
+	if err != nil {
+		prp.log.Error().Err(err).Msg("CreatePaymentRequest: failed to build insert SQL query")
+		return 0, errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "payment_request_repository.CreatePaymentRequest",
+		QueryRow: query,
+	}
+
+	err = prp.db.DB().QueryRowContext(ctx, queryStruct, args...).Scan(&requestId)
+	if err != nil {
+		prp.log.Error().Err(err).Msg("CreatePaymentRequest: failed to insert payment request")
+		return 0, errresponse.ErrResponse(err)
+	}
+
+	return requestId, nil
+}
+
+// GetPaymentRequestsByPayerId возвращает входящие запросы монет, начиная с последних.
+func (prp *PaymentRequestRepo) GetPaymentRequestsByPayerId(ctx context.Context, payerId int,
+	filter models.PaymentRequestsFilter) ([]models.PaymentRequest, error) {
+	var requests []models.PaymentRequest
+
+	builder := squirrel.Select(
+		"pr.id AS id",
+		"pr.requester_id AS requester_id",
+		"requester.username AS requester",
+		"pr.payer_id AS payer_id",
+		"pr.amount AS amount",
+		"pr.reason AS reason",
+		"pr.status AS status",
+		"pr.transaction_id AS transaction_id",
+		"pr.created_at AS created_at",
+	).
+		PlaceholderFormat(squirrel.Dollar).
+		From("payment_requests pr").
+		Join("users requester ON requester.id = pr.requester_id").
+		Where(squirrel.Eq{"pr.payer_id": payerId}).
+		OrderBy("pr.id DESC").
+		Limit(uint64(filter.Limit)).
+		Offset(uint64(filter.Offset))
+
+	if filter.Status != "" {
+		builder = builder.Where(squirrel.Eq{"pr.status": filter.Status})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		prp.log.Error().Err(err).Msg("GetPaymentRequestsByPayerId: failed to build select SQL query")
+		return nil, errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "payment_request_repository.GetPaymentRequestsByPayerId",
+		QueryRow: query,
+	}
+
+	err = prp.db.DB().ScanAllContext(ctx, &requests, queryStruct, args...)
+	if err != nil {
+		prp.log.Error().Err(err).Msg("GetPaymentRequestsByPayerId: failed to scan rows")
+		return nil, errresponse.ErrResponse(err)
+	}
+
+	return requests, nil
+}
+
+// GetPaymentRequest блокирует запрос монет до конца транзакции.
+// Строки пользователей не блокируются, их блокирует перевод.
+func (prp *PaymentRequestRepo) GetPaymentRequest(ctx context.Context, requestId int) (models.PaymentRequest, error) {
+	var request models.PaymentRequest
+
+	selectQuery := squirrel.Select(
+		"pr.id", "pr.requester_id", "requester.username", "pr.payer_id", "payer.username",
+		"pr.amount", "pr.reason", "pr.status",
+	).
+		PlaceholderFormat(squirrel.Dollar).
+		From("payment_requests pr").
+		Join("users requester ON requester.id = pr.requester_id").
+		Join("users payer ON payer.id = pr.payer_id").
+		Where(squirrel.Eq{"pr.id": requestId}).
+		Suffix("FOR UPDATE OF pr")
+
+	query, args, err := selectQuery.ToSql()
+	if err != nil {
+		prp.log.Error().Err(err).Msg("GetPaymentRequest: failed to build select SQL query")
+		return models.PaymentRequest{}, errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "payment_request_repository.GetPaymentRequest",
+		QueryRow: query,
+	}
+
+	err = prp.db.DB().QueryRowContext(ctx, queryStruct, args...).Scan(
+		&request.Id,
+		&request.RequesterId,
+		&request.Requester,
+		&request.PayerId,
+		&request.Payer,
+		&request.Amount,
+		&request.Reason,
+		&request.Status,
+	)
+	if err != nil {
+		prp.log.Error().Err(err).Msg("GetPaymentRequest: failed to lock payment request")
+		return models.PaymentRequest{}, errresponse.ErrResponse(err, i18n.EntityPaymentRequest)
+	}
+
+	return request, nil
+}
+
+func (prp *PaymentRequestRepo) ResolvePaymentRequest(ctx context.Context, requestId int, status string,
+	transactionId *int) error {
+	updateQuery := squirrel.Update("payment_requests").PlaceholderFormat(squirrel.Dollar).
+		Set("status", status).
+		Set("transaction_id", transactionId).
+		Set("resolved_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{"id": requestId, "status": models.PaymentRequestStatusPending})
+
+	query, args, err := updateQuery.ToSql()
+	if err != nil {
+		prp.log.Error().Err(err).Msg("ResolvePaymentRequest: failed to build update SQL query")
+		return errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "payment_request_repository.ResolvePaymentRequest",
+		QueryRow: query,
+	}
+
+	_, err = prp.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		prp.log.Error().Err(err).Msg("ResolvePaymentRequest: failed to update payment request")
+		return errresponse.ErrResponse(err)
+	}
+
+	return nil
+}
+
+// MarkPaymentRequestReversed отмечает одобренный запрос, оплаченный переводом
+// transactionId, отменённым. Если перевод выполнен не по запросу, ничего не меняется.
+func (prp *PaymentRequestRepo) MarkPaymentRequestReversed(ctx context.Context, transactionId int) error {
+	updateQuery := squirrel.Update("payment_requests").PlaceholderFormat(squirrel.Dollar).
+		Set("status", models.PaymentRequestStatusReversed).
+		Where(squirrel.Eq{"transaction_id": transactionId, "status": models.PaymentRequestStatusApproved})
+
+	query, args, err := updateQuery.ToSql()
+	if err != nil {
+		prp.log.Error().Err(err).Msg("MarkPaymentRequestReversed: failed to build update SQL query")
+		return errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "payment_request_repository.MarkPaymentRequestReversed",
+		QueryRow: query,
+	}
+
+	_, err = prp.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		prp.log.Error().Err(err).Msg("MarkPaymentRequestReversed: failed to update payment request")
+		return errresponse.ErrResponse(err)
+	}
+
+	return nil
+}
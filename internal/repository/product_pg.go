@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+
+	db "github.com/MaksimovDenis/Avito_merch_shop/internal/client"
+	errresponse "github.com/MaksimovDenis/Avito_merch_shop/internal/err_response"
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/models"
+	"github.com/Masterminds/squirrel"
+	"github.com/rs/zerolog"
+)
+
+type Product interface {
+	CreateProduct(ctx context.Context, product models.Product) (models.Product, error)
+	UpdateProduct(ctx context.Context, name string, update models.ProductUpdate) (models.Product, error)
+}
+
+type ProductRepo struct {
+	db  db.Client
+	log zerolog.Logger
+}
+
+func newProductRepository(db db.Client, log zerolog.Logger) *ProductRepo {
+	return &ProductRepo{
+		db:  db,
+		log: log,
+	}
+}
+
+func (prp *ProductRepo) CreateProduct(ctx context.Context, product models.Product) (models.Product, error) {
+	var res models.Product
+
+	insertQuery := squirrel.Insert("products").PlaceholderFormat(squirrel.Dollar).
+		Columns("name", "price", "available", "stock").
+		Values(product.Name, product.Price, product.Available, product.Stock).
+		Suffix("RETURNING name, price, available, stock")
+
+	query, args, err := insertQuery.ToSql()
+	if err != nil {
+		prp.log.Error().Err(err).Msg("CreateProduct: failed to build insert SQL query")
+		return res, errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "product_repository.CreateProduct",
+		QueryRow: query,
+	}
+
+	err = prp.db.DB().QueryRowContext(ctx, queryStruct, args...).
+		Scan(&res.Name, &res.Price, &res.Available, &res.Stock)
+	if err != nil {
+		prp.log.Error().Err(err).Msg("CreateProduct: failed to insert product")
+		return res, errresponse.ErrResponse(err)
+	}
+
+	return res, nil
+}
+
+func (prp *ProductRepo) UpdateProduct(ctx context.Context, name string, update models.ProductUpdate) (
+	models.Product, error) {
+	var res models.Product
+
+	updateQuery := squirrel.Update("products").PlaceholderFormat(squirrel.Dollar).
+		Where(squirrel.Eq{"name": name}).
+		Suffix("RETURNING name, price, available, stock")
+
+	if update.Name != nil {
+		updateQuery = updateQuery.Set("name", *update.Name)
+	}
+
+	if update.Price != nil {
+		updateQuery = updateQuery.Set("price", *update.Price)
+	}
+
+	if update.Available != nil {
+		updateQuery = updateQuery.Set("available", *update.Available)
+	}
+
+	switch {
+	case update.UnlimitedStock:
+		updateQuery = updateQuery.Set("stock", nil)
+	case update.Stock != nil:
+		updateQuery = updateQuery.Set("stock", *update.Stock)
+	}
+
+	query, args, err := updateQuery.ToSql()
+	if err != nil {
+		prp.log.Error().Err(err).Msg("UpdateProduct: failed to build update SQL query")
+		return res, errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "product_repository.UpdateProduct",
+		QueryRow: query,
+	}
+
+	err = prp.db.DB().QueryRowContext(ctx, queryStruct, args...).
+		Scan(&res.Name, &res.Price, &res.Available, &res.Stock)
+	if err != nil {
+		prp.log.Error().Err(err).Msg("UpdateProduct: failed to update product")
+		return res, errresponse.ErrResponse(err, name)
+	}
+
+	return res, nil
+}
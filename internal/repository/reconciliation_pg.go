@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+
+	db "github.com/MaksimovDenis/Avito_merch_shop/internal/client"
+	errresponse "github.com/MaksimovDenis/Avito_merch_shop/internal/err_response"
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/models"
+	"github.com/Masterminds/squirrel"
+	"github.com/rs/zerolog"
+)
+
+type Reconciliation interface {
+	GetExpectedBalances(ctx context.Context) ([]models.UserBalance, error)
+}
+
+type ReconciliationRepo struct {
+	db  db.Client
+	log zerolog.Logger
+}
+
+func newReconciliationRepository(db db.Client, log zerolog.Logger) *ReconciliationRepo {
+	return &ReconciliationRepo{
+		db:  db,
+		log: log,
+	}
+}
+
+// GetExpectedBalances возвращает для каждого пользователя текущий баланс и баланс,
+// восстановленный по истории: стартовые и начисленные монеты за вычетом
+// сгоревших, минус невозвращённые покупки, минус отправленные и плюс
+// полученные переводы. Ожидающий подтверждения перевод уже списан
+// с отправителя, но ещё не зачислен получателю, а отклонённый или просроченный
+// не учитывается вовсе. Отменённый администратором перевод учитывается вместе
+// со встречным переводом, который возвращает монеты.
+func (rrp *ReconciliationRepo) GetExpectedBalances(ctx context.Context) ([]models.UserBalance, error) {
+	var balances []models.UserBalance
+
+	granted := squirrel.Select("COALESCE(SUM(e.amount), 0)").
+		From("ledger_entries e").
+		Join("ledger_journals j ON j.id = e.journal_id").
+		Where("e.user_id = u.id").
+		Where(squirrel.Eq{"j.operation": []string{
+			models.JournalGrant, models.JournalOpening, models.JournalIssuance, models.JournalCoinExpiration,
+		}})
+
+	spent := squirrel.Select("COALESCE(SUM(pu.total_price), 0)").
+		From("purchases pu").
+		Where("pu.user_id = u.id").
+		Where(squirrel.Eq{"pu.status": models.PurchaseStatusCompleted})
+
+	sent := squirrel.Select("COALESCE(SUM(t.amount), 0)").
+		From("transactions t").
+		Where("t.sender_id = u.id").
+		Where(squirrel.Eq{"t.status": []string{
+			models.TransferStatusCompleted, models.TransferStatusPending, models.TransferStatusReversal,
+		}})
+
+	received := squirrel.Select("COALESCE(SUM(t.amount), 0)").
+		From("transactions t").
+		Where("t.receiver_id = u.id").
+		Where(squirrel.Eq{"t.status": []string{models.TransferStatusCompleted, models.TransferStatusReversal}})
+
+	builder := squirrel.Select("u.id AS user_id", "u.username AS username", "u.coins AS coins").
+		Column(squirrel.Alias(
+			squirrel.Expr("(?) - (?) - (?) + (?)", granted, spent, sent, received), "expected")).
+		PlaceholderFormat(squirrel.Dollar).
+		From("users u").
+		OrderBy("u.id")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		rrp.log.Error().Err(err).Msg("GetExpectedBalances: failed to build select SQL query")
+		return nil, errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "reconciliation_repository.GetExpectedBalances",
+		QueryRow: query,
+	}
+
+	err = rrp.db.DB().ScanAllContext(ctx, &balances, queryStruct, args...)
+	if err != nil {
+		rrp.log.Error().Err(err).Msg("GetExpectedBalances: failed to scan rows")
+		return nil, errresponse.ErrResponse(err)
+	}
+
+	return balances, nil
+}
@@ -8,11 +8,31 @@ import (
 type Repository struct {
 	Authorization
 	Shop
+	Cart
+	Product
+	Idempotency
+	Ledger
+	Reconciliation
+	PaymentRequest
+	TransferLimits
+	Issuance
+	CoinLots
+	TransferReversal
 }
 
 func NewRepository(db db.Client, log zerolog.Logger) *Repository {
 	return &Repository{
-		Authorization: newAuthRepository(db, log),
-		Shop:          newShopRepository(db, log),
+		Authorization:    newAuthRepository(db, log),
+		Shop:             newShopRepository(db, log),
+		Cart:             newCartRepository(db, log),
+		Product:          newProductRepository(db, log),
+		Idempotency:      newIdempotencyRepository(db, log),
+		Ledger:           newLedgerRepository(db, log),
+		Reconciliation:   newReconciliationRepository(db, log),
+		PaymentRequest:   newPaymentRequestRepository(db, log),
+		TransferLimits:   newTransferLimitsRepository(db, log),
+		Issuance:         newIssuanceRepository(db, log),
+		CoinLots:         newCoinLotsRepository(db, log),
+		TransferReversal: newTransferReversalRepository(db, log),
 	}
 }
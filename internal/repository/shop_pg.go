@@ -2,24 +2,54 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	db "github.com/MaksimovDenis/Avito_merch_shop/internal/client"
 	errresponse "github.com/MaksimovDenis/Avito_merch_shop/internal/err_response"
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/i18n"
 	"github.com/MaksimovDenis/Avito_merch_shop/internal/models"
 	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
 	"github.com/rs/zerolog"
 )
 
 type Shop interface {
-	UpdateBalanceForPurchase(ctx context.Context, userId int, productName string) (*int, error)
-	InsertPurchaseRecord(ctx context.Context, userId int, productId int) error
+	UpdateBalanceForPurchase(ctx context.Context, userId int, productName string, quantity int) (
+		productId int, unitPrice int, spends []models.CoinLotSpend, err error)
+	InsertPurchaseRecord(ctx context.Context, userId int, productId int, quantity int, unitPrice int) (
+		purchaseId int, err error)
+	GetPurchaseForRefund(ctx context.Context, userId int, purchaseId int, refundWindow time.Duration) (
+		models.Purchase, error)
+	MarkPurchaseRefunded(ctx context.Context, purchaseId int) error
+	RestoreBalanceForRefund(ctx context.Context, userId int, productId int, quantity int, amount int,
+		lots []models.CoinLotSpend) error
+	GetPurchaseByUserId(ctx context.Context, userId int, purchaseId int) (models.PurchaseRecord, error)
+	GetPurchasesByUserId(ctx context.Context, userId int, filter models.PurchasesFilter) ([]models.PurchaseRecord, error)
+	PurchasesSummaryByUserId(ctx context.Context, userId int) (total int, spent int, err error)
 	UserBalanceByName(ctx context.Context, username string) (userId int, coins int, err error)
-	UpdateSenderBalance(ctx context.Context, sender string, amount int) (senderId int, err error)
-	UpdateReceiverBalance(ctx context.Context, receiver string, amount int) (receiverId int, err error)
-	AddTransaction(ctx context.Context, senderId int, receiverId int, amount int) error
+	LockUsersByName(ctx context.Context, usernames ...string) ([]models.User, error)
+	UpdateSenderBalance(ctx context.Context, sender string, amount int) (
+		senderId int, spends []models.CoinLotSpend, err error)
+	UpdateReceiverBalance(ctx context.Context, receiver string, amount int, lots []models.CoinLotSpend) (
+		receiverId int, err error)
+	AddTransaction(ctx context.Context, senderId int, receiverId int, amount int, message string) (
+		transactionId int, err error)
+	AddPendingTransaction(ctx context.Context, senderId int, receiverId int, amount int, message string,
+		acceptWindow time.Duration) (transactionId int, err error)
+	GetPendingTransfer(ctx context.Context, transactionId int) (models.PendingTransfer, error)
+	ResolvePendingTransfer(ctx context.Context, transactionId int, status string) error
+	CreditBalance(ctx context.Context, userId int, amount int, lots []models.CoinLotSpend) error
+	DebitBalance(ctx context.Context, userId int, amount int, allowNegative bool) error
+	ExpiredPendingTransferIds(ctx context.Context, limit int) ([]int, error)
 	GetItemsByUserId(ctx context.Context, userId int) ([]models.Items, error)
 	SentCoinsByUserId(ctx context.Context, userId int) ([]models.SentCoins, error)
 	ReceivedCoinsByUserId(ctx context.Context, userId int) ([]models.ReceivedCoins, error)
+	GetTransactionByUserId(ctx context.Context, userId int, transactionId int) (models.Transaction, error)
+	GetTransactionsByUserId(ctx context.Context, userId int, filter models.TransactionsFilter) (
+		[]models.Transaction, error)
+	GetProducts(ctx context.Context, filter models.ProductsFilter) ([]models.Product, error)
+	CountProducts(ctx context.Context) (int, error)
 }
 
 type ShopRepo struct {
@@ -34,21 +64,27 @@ func newShopRepository(db db.Client, log zerolog.Logger) *ShopRepo {
 	}
 }
 
-func (srp *ShopRepo) UpdateBalanceForPurchase(ctx context.Context, userId int, productName string) (*int, error) {
+// UpdateBalanceForPurchase списывает стоимость товара с баланса пользователя
+// и возвращает цену единицы товара, по которой прошло списание,
+// и израсходованные части партий монет.
+func (srp *ShopRepo) UpdateBalanceForPurchase(ctx context.Context, userId int, productName string, quantity int) (
+	productId int, unitPrice int, spends []models.CoinLotSpend, err error) {
+	productId, err = srp.reserveStock(ctx, productName, quantity)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+
 	updateQuery := squirrel.Update("users").PlaceholderFormat(squirrel.Dollar).
-		Set("coins", squirrel.Expr("users.coins - products.price")).
+		Set("coins", squirrel.Expr("users.coins - products.price * ?", quantity)).
 		From("products").
-		Where(
-			squirrel.Eq{"users.id": userId, "products.name": productName},
-			squirrel.Expr("users.coins >= products.price"),
-		).
-		Suffix("RETURNING products.id")
+		Where(squirrel.Eq{"users.id": userId, "products.id": productId}).
+		Suffix("RETURNING products.id, products.price")
 
 	query, args, err := updateQuery.ToSql()
 	if err != nil {
 		srp.log.Error().Err(err).Msg("UpdateBalanceForPurchase: failed to build update SQL query")
 
-		return nil, errresponse.ErrResponse(err)
+		return 0, 0, nil, errresponse.ErrResponse(err)
 	}
 
 	queryStruct := db.Query{
@@ -56,26 +92,101 @@ func (srp *ShopRepo) UpdateBalanceForPurchase(ctx context.Context, userId int, p
 		QueryRow: query,
 	}
 
-	var productId int
+	err = srp.db.DB().QueryRowContext(ctx, queryStruct, args...).Scan(&productId, &unitPrice)
+	if err != nil {
+		srp.log.Error().Err(err).Msg("UpdateBalanceForPurchase: failed to update user data")
+		return 0, 0, nil, errresponse.ErrResponse(err, productName)
+	}
+
+	spends, err = srp.spendCoinLots(ctx, userId, unitPrice*quantity)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+
+	return productId, unitPrice, spends, nil
+}
+
+// reserveStock списывает остаток товара, если его количество ограничено.
+// Списание выполняется одним условным UPDATE, поэтому строка товара
+// блокируется только при ограниченном остатке, а покупки товаров без
+// ограничения не ждут друг друга.
+func (srp *ShopRepo) reserveStock(ctx context.Context, productName string, quantity int) (int, error) {
+	var (
+		productId int
+		stock     *int
+	)
+
+	updateQuery := squirrel.Update("products").PlaceholderFormat(squirrel.Dollar).
+		Set("stock", squirrel.Expr("stock - ?", quantity)).
+		Where(
+			squirrel.Eq{"name": productName, "available": true},
+			squirrel.NotEq{"stock": nil},
+			squirrel.GtOrEq{"stock": quantity},
+		).
+		Suffix("RETURNING id")
+
+	query, args, err := updateQuery.ToSql()
+	if err != nil {
+		srp.log.Error().Err(err).Msg("reserveStock: failed to build update SQL query")
+		return 0, errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "user_repository.reserveStock",
+		QueryRow: query,
+	}
 
 	err = srp.db.DB().QueryRowContext(ctx, queryStruct, args...).Scan(&productId)
+	if err == nil {
+		return productId, nil
+	}
+
+	if !errors.Is(err, pgx.ErrNoRows) {
+		srp.log.Error().Err(err).Msg("reserveStock: failed to decrement stock")
+		return 0, errresponse.ErrResponse(err)
+	}
+
+	selectQuery := squirrel.Select("id", "stock").PlaceholderFormat(squirrel.Dollar).
+		From("products").
+		Where(squirrel.Eq{"name": productName, "available": true})
+
+	query, args, err = selectQuery.ToSql()
 	if err != nil {
-		srp.log.Error().Err(err).Msg("UpdateBalanceForPurchase: failed to update user data")
-		return nil, errresponse.ErrResponse(err, productName)
+		srp.log.Error().Err(err).Msg("reserveStock: failed to build select SQL query")
+		return 0, errresponse.ErrResponse(err)
+	}
+
+	queryStruct = db.Query{
+		Name:     "user_repository.reserveStock",
+		QueryRow: query,
+	}
+
+	err = srp.db.DB().QueryRowContext(ctx, queryStruct, args...).Scan(&productId, &stock)
+	if err != nil {
+		srp.log.Error().Err(err).Msg("reserveStock: failed to select product")
+		return 0, errresponse.ErrResponse(err, productName)
 	}
 
-	return &productId, nil
+	if stock == nil {
+		return productId, nil
+	}
+
+	srp.log.Warn().Str("product", productName).Int("stock", *stock).Msg("reserveStock: out of stock")
+
+	return 0, errresponse.OutOfStock(productName)
 }
 
-func (srp *ShopRepo) InsertPurchaseRecord(ctx context.Context, userId int, productId int) error {
+func (srp *ShopRepo) InsertPurchaseRecord(ctx context.Context, userId int, productId int, quantity int,
+	unitPrice int) (purchaseId int, err error) {
 	insertQuery := squirrel.Insert("purchases").PlaceholderFormat(squirrel.Dollar).
-		Columns("user_id", "products_id").
-		Values(userId, productId)
+		Columns("user_id", "products_id", "quantity", "unit_price", "total_price").
+		Values(userId, productId, quantity, unitPrice, unitPrice*quantity).
+		Suffix("RETURNING id")
 
 	query, args, err := insertQuery.ToSql()
 	if err != nil {
 		srp.log.Error().Err(err).Msg("InsertPurchaseRecord: failed to build insert SQL query")
-		return errresponse.ErrResponse(err)
+		return 0, errresponse.ErrResponse(err)
 	}
 
 	queryStruct := db.Query{
@@ -83,9 +194,130 @@ func (srp *ShopRepo) InsertPurchaseRecord(ctx context.Context, userId int, produ
 		QueryRow: query,
 	}
 
-	_, err = srp.db.DB().ExecContext(ctx, queryStruct, args...)
+	err = srp.db.DB().QueryRowContext(ctx, queryStruct, args...).Scan(&purchaseId)
 	if err != nil {
 		srp.log.Error().Err(err).Msg("InsertPurchaseRecord: failed to insert purchase")
+		return 0, errresponse.ErrResponse(err)
+	}
+
+	return purchaseId, nil
+}
+
+// GetPurchaseForRefund блокирует запись о покупке пользователя до конца транзакции
+// и проверяет, не истёк ли срок возврата.
+func (srp *ShopRepo) GetPurchaseForRefund(ctx context.Context, userId int, purchaseId int,
+	refundWindow time.Duration) (models.Purchase, error) {
+	var purchase models.Purchase
+
+	selectQuery := squirrel.Select("id", "products_id", "quantity", "total_price", "status").
+		Column("purchased_at >= NOW() - ? * INTERVAL '1 second'", refundWindow.Seconds()).
+		PlaceholderFormat(squirrel.Dollar).
+		From("purchases").
+		Where(squirrel.Eq{"id": purchaseId, "user_id": userId}).
+		Suffix("FOR UPDATE")
+
+	query, args, err := selectQuery.ToSql()
+	if err != nil {
+		srp.log.Error().Err(err).Msg("GetPurchaseForRefund: failed to build select SQL query")
+		return models.Purchase{}, errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "user_repository.GetPurchaseForRefund",
+		QueryRow: query,
+	}
+
+	err = srp.db.DB().QueryRowContext(ctx, queryStruct, args...).Scan(
+		&purchase.Id,
+		&purchase.ProductId,
+		&purchase.Quantity,
+		&purchase.TotalPrice,
+		&purchase.Status,
+		&purchase.WithinRefundWindow,
+	)
+	if err != nil {
+		srp.log.Error().Err(err).Msg("GetPurchaseForRefund: failed to lock purchase")
+		return models.Purchase{}, errresponse.ErrResponse(err, i18n.EntityPurchase)
+	}
+
+	return purchase, nil
+}
+
+func (srp *ShopRepo) MarkPurchaseRefunded(ctx context.Context, purchaseId int) error {
+	updateQuery := squirrel.Update("purchases").PlaceholderFormat(squirrel.Dollar).
+		Set("status", models.PurchaseStatusRefunded).
+		Set("refunded_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{"id": purchaseId})
+
+	query, args, err := updateQuery.ToSql()
+	if err != nil {
+		srp.log.Error().Err(err).Msg("MarkPurchaseRefunded: failed to build update SQL query")
+		return errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "user_repository.MarkPurchaseRefunded",
+		QueryRow: query,
+	}
+
+	_, err = srp.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		srp.log.Error().Err(err).Msg("MarkPurchaseRefunded: failed to update purchase")
+		return errresponse.ErrResponse(err)
+	}
+
+	return nil
+}
+
+// RestoreBalanceForRefund возвращает пользователю уплаченную сумму со сроком
+// действия израсходованных покупкой партий lots и возвращает товар на склад,
+// если его количество ограничено.
+func (srp *ShopRepo) RestoreBalanceForRefund(ctx context.Context, userId int, productId int, quantity int,
+	amount int, lots []models.CoinLotSpend) error {
+	updateQuery := squirrel.Update("users").PlaceholderFormat(squirrel.Dollar).
+		Set("coins", squirrel.Expr("coins + ?", amount)).
+		Where(squirrel.Eq{"id": userId})
+
+	query, args, err := updateQuery.ToSql()
+	if err != nil {
+		srp.log.Error().Err(err).Msg("RestoreBalanceForRefund: failed to build update SQL query")
+		return errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "user_repository.RestoreBalanceForRefund",
+		QueryRow: query,
+	}
+
+	_, err = srp.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		srp.log.Error().Err(err).Msg("RestoreBalanceForRefund: failed to update user balance")
+		return errresponse.ErrResponse(err)
+	}
+
+	if err := srp.addCoinLots(ctx, userId, amount, lots); err != nil {
+		return err
+	}
+
+	updateQuery = squirrel.Update("products").PlaceholderFormat(squirrel.Dollar).
+		Set("stock", squirrel.Expr("stock + ?", quantity)).
+		Where(squirrel.Eq{"id": productId}).
+		Where(squirrel.NotEq{"stock": nil})
+
+	query, args, err = updateQuery.ToSql()
+	if err != nil {
+		srp.log.Error().Err(err).Msg("RestoreBalanceForRefund: failed to build stock SQL query")
+		return errresponse.ErrResponse(err)
+	}
+
+	queryStruct = db.Query{
+		Name:     "user_repository.RestoreBalanceForRefund",
+		QueryRow: query,
+	}
+
+	_, err = srp.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		srp.log.Error().Err(err).Msg("RestoreBalanceForRefund: failed to restore stock")
 		return errresponse.ErrResponse(err)
 	}
 
@@ -119,7 +351,43 @@ func (srp *ShopRepo) UserBalanceByName(ctx context.Context, username string) (us
 	return userId, coins, nil
 }
 
-func (srp *ShopRepo) UpdateSenderBalance(ctx context.Context, sender string, amount int) (senderId int, err error) {
+// LockUsersByName блокирует строки пользователей до конца транзакции.
+// Строки блокируются в порядке возрастания id, чтобы параллельные транзакции,
+// блокирующие одних и тех же пользователей, не могли взаимно заблокироваться.
+func (srp *ShopRepo) LockUsersByName(ctx context.Context, usernames ...string) ([]models.User, error) {
+	var users []models.User
+
+	selectQuery := squirrel.Select("id", "username", "coins").
+		PlaceholderFormat(squirrel.Dollar).
+		From("users").
+		Where(squirrel.Eq{"username": usernames}).
+		OrderBy("id").
+		Suffix("FOR UPDATE")
+
+	query, args, err := selectQuery.ToSql()
+	if err != nil {
+		srp.log.Error().Err(err).Msg("LockUsersByName: failed to build select SQL query")
+		return nil, errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "user_repository.LockUsersByName",
+		QueryRow: query,
+	}
+
+	err = srp.db.DB().ScanAllContext(ctx, &users, queryStruct, args...)
+	if err != nil {
+		srp.log.Error().Err(err).Msg("LockUsersByName: failed to lock users")
+		return nil, errresponse.ErrResponse(err)
+	}
+
+	return users, nil
+}
+
+// UpdateSenderBalance списывает сумму перевода с баланса отправителя и возвращает
+// израсходованные части его партий монет.
+func (srp *ShopRepo) UpdateSenderBalance(ctx context.Context, sender string, amount int) (
+	senderId int, spends []models.CoinLotSpend, err error) {
 	updateQuerySender := squirrel.Update("users").
 		PlaceholderFormat(squirrel.Dollar).
 		Set("coins", squirrel.Expr("coins - ?", amount)).
@@ -130,7 +398,7 @@ func (srp *ShopRepo) UpdateSenderBalance(ctx context.Context, sender string, amo
 	if err != nil {
 		srp.log.Error().Err(err).Msg("UpdateSenderBalance: failed to build update SQL query")
 
-		return 0, errresponse.ErrResponse(err)
+		return 0, nil, errresponse.ErrResponse(err)
 	}
 
 	queryStruct := db.Query{
@@ -142,14 +410,21 @@ func (srp *ShopRepo) UpdateSenderBalance(ctx context.Context, sender string, amo
 	if err != nil {
 		srp.log.Error().Err(err).Msg("UpdateSenderBalance: failed to update sender balance")
 
-		return 0, errresponse.ErrResponse(err, sender)
+		return 0, nil, errresponse.ErrResponse(err, sender)
 	}
 
-	return senderId, nil
+	spends, err = srp.spendCoinLots(ctx, senderId, amount)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return senderId, spends, nil
 }
 
-func (srp *ShopRepo) UpdateReceiverBalance(ctx context.Context, receiver string, amount int) (
-	receiverId int, err error) {
+// UpdateReceiverBalance зачисляет сумму перевода получателю. Зачисленные монеты
+// сохраняют срок действия партий отправителя lots.
+func (srp *ShopRepo) UpdateReceiverBalance(ctx context.Context, receiver string, amount int,
+	lots []models.CoinLotSpend) (receiverId int, err error) {
 	updateQuerySender := squirrel.Update("users").
 		PlaceholderFormat(squirrel.Dollar).
 		Set("coins", squirrel.Expr("coins + ?", amount)).
@@ -175,19 +450,30 @@ func (srp *ShopRepo) UpdateReceiverBalance(ctx context.Context, receiver string,
 		return 0, errresponse.ErrResponse(err, receiver)
 	}
 
+	if err := srp.addCoinLots(ctx, receiverId, amount, lots); err != nil {
+		return 0, err
+	}
+
 	return receiverId, nil
 }
 
-func (srp *ShopRepo) AddTransaction(ctx context.Context, senderId int, receiverId int, amount int) error {
+func (srp *ShopRepo) AddTransaction(ctx context.Context, senderId int, receiverId int, amount int,
+	message string) (transactionId int, err error) {
+	var messageValue *string
+	if message != "" {
+		messageValue = &message
+	}
+
 	insertQueryTransact := squirrel.Insert("transactions").
 		PlaceholderFormat(squirrel.Dollar).
-		Columns("sender_id", "receiver_id", "amount").
-		Values(senderId, receiverId, amount)
+		Columns("sender_id", "receiver_id", "amount", "message").
+		Values(senderId, receiverId, amount, messageValue).
+		Suffix("RETURNING id")
 
 	query, args, err := insertQueryTransact.ToSql()
 	if err != nil {
 		srp.log.Error().Err(err).Msg("AddTransaction: failed to build update SQL query")
-		return errresponse.ErrResponse(err)
+		return 0, errresponse.ErrResponse(err)
 	}
 
 	queryStruct := db.Query{
@@ -195,15 +481,339 @@ func (srp *ShopRepo) AddTransaction(ctx context.Context, senderId int, receiverI
 		QueryRow: query,
 	}
 
-	_, err = srp.db.DB().ExecContext(ctx, queryStruct, args...)
+	err = srp.db.DB().QueryRowContext(ctx, queryStruct, args...).Scan(&transactionId)
 	if err != nil {
 		srp.log.Error().Err(err).Msg("AddTransaction: failed to add transaction")
+		return 0, errresponse.ErrResponse(err)
+	}
+
+	return transactionId, nil
+}
+
+// AddPendingTransaction добавляет перевод, ожидающий подтверждения получателем.
+// Срок подтверждения отсчитывается от текущего времени БД.
+func (srp *ShopRepo) AddPendingTransaction(ctx context.Context, senderId int, receiverId int, amount int,
+	message string, acceptWindow time.Duration) (transactionId int, err error) {
+	var messageValue *string
+	if message != "" {
+		messageValue = &message
+	}
+
+	insertQuery := squirrel.Insert("transactions").
+		PlaceholderFormat(squirrel.Dollar).
+		Columns("sender_id", "receiver_id", "amount", "message", "status", "expires_at").
+		Values(senderId, receiverId, amount, messageValue, models.TransferStatusPending,
+			squirrel.Expr("NOW() + ? * INTERVAL '1 second'", acceptWindow.Seconds())).
+		Suffix("RETURNING id")
+
+	query, args, err := insertQuery.ToSql()
+	if err != nil {
+		srp.log.Error().Err(err).Msg("AddPendingTransaction: failed to build insert SQL query")
+		return 0, errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "user_repository.AddPendingTransaction",
+		QueryRow: query,
+	}
+
+	err = srp.db.DB().QueryRowContext(ctx, queryStruct, args...).Scan(&transactionId)
+	if err != nil {
+		srp.log.Error().Err(err).Msg("AddPendingTransaction: failed to add transaction")
+		return 0, errresponse.ErrResponse(err)
+	}
+
+	return transactionId, nil
+}
+
+// GetPendingTransfer блокирует перевод до конца транзакции и сообщает,
+// истёк ли срок его подтверждения.
+func (srp *ShopRepo) GetPendingTransfer(ctx context.Context, transactionId int) (models.PendingTransfer, error) {
+	var transfer models.PendingTransfer
+
+	selectQuery := squirrel.Select("id", "sender_id", "receiver_id", "amount", "status").
+		Column("COALESCE(expires_at <= NOW(), FALSE)").
+		PlaceholderFormat(squirrel.Dollar).
+		From("transactions").
+		Where(squirrel.Eq{"id": transactionId}).
+		Suffix("FOR UPDATE")
+
+	query, args, err := selectQuery.ToSql()
+	if err != nil {
+		srp.log.Error().Err(err).Msg("GetPendingTransfer: failed to build select SQL query")
+		return models.PendingTransfer{}, errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "user_repository.GetPendingTransfer",
+		QueryRow: query,
+	}
+
+	err = srp.db.DB().QueryRowContext(ctx, queryStruct, args...).Scan(
+		&transfer.Id,
+		&transfer.SenderId,
+		&transfer.ReceiverId,
+		&transfer.Amount,
+		&transfer.Status,
+		&transfer.Expired,
+	)
+	if err != nil {
+		srp.log.Error().Err(err).Msg("GetPendingTransfer: failed to lock transaction")
+		return models.PendingTransfer{}, errresponse.ErrResponse(err, i18n.EntityTransfer)
+	}
+
+	return transfer, nil
+}
+
+func (srp *ShopRepo) ResolvePendingTransfer(ctx context.Context, transactionId int, status string) error {
+	updateQuery := squirrel.Update("transactions").PlaceholderFormat(squirrel.Dollar).
+		Set("status", status).
+		Set("resolved_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{"id": transactionId, "status": models.TransferStatusPending})
+
+	query, args, err := updateQuery.ToSql()
+	if err != nil {
+		srp.log.Error().Err(err).Msg("ResolvePendingTransfer: failed to build update SQL query")
+		return errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "user_repository.ResolvePendingTransfer",
+		QueryRow: query,
+	}
+
+	_, err = srp.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		srp.log.Error().Err(err).Msg("ResolvePendingTransfer: failed to update transaction")
+		return errresponse.ErrResponse(err)
+	}
+
+	return nil
+}
+
+// CreditBalance зачисляет монеты на баланс пользователя. Зачисленные монеты
+// получают срок действия партий lots, а сумма сверх них, например начисление
+// администратором, образует новую партию.
+func (srp *ShopRepo) CreditBalance(ctx context.Context, userId int, amount int, lots []models.CoinLotSpend) error {
+	updateQuery := squirrel.Update("users").PlaceholderFormat(squirrel.Dollar).
+		Set("coins", squirrel.Expr("coins + ?", amount)).
+		Where(squirrel.Eq{"id": userId})
+
+	query, args, err := updateQuery.ToSql()
+	if err != nil {
+		srp.log.Error().Err(err).Msg("CreditBalance: failed to build update SQL query")
+		return errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "user_repository.CreditBalance",
+		QueryRow: query,
+	}
+
+	_, err = srp.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		srp.log.Error().Err(err).Msg("CreditBalance: failed to update user balance")
+		return errresponse.ErrResponse(err)
+	}
+
+	return srp.addCoinLots(ctx, userId, amount, lots)
+}
+
+// DebitBalance списывает монеты с баланса пользователя. Если allowNegative
+// установлен, баланс может стать отрицательным до конца текущей транзакции.
+func (srp *ShopRepo) DebitBalance(ctx context.Context, userId int, amount int, allowNegative bool) error {
+	if allowNegative {
+		queryStruct := db.Query{
+			Name:     "user_repository.DebitBalance",
+			QueryRow: "SELECT set_config('shop.allow_negative_balance', 'on', true)",
+		}
+
+		_, err := srp.db.DB().ExecContext(ctx, queryStruct)
+		if err != nil {
+			srp.log.Error().Err(err).Msg("DebitBalance: failed to allow negative balance")
+			return errresponse.ErrResponse(err)
+		}
+	}
+
+	updateQuery := squirrel.Update("users").PlaceholderFormat(squirrel.Dollar).
+		Set("coins", squirrel.Expr("coins - ?", amount)).
+		Where(squirrel.Eq{"id": userId})
+
+	query, args, err := updateQuery.ToSql()
+	if err != nil {
+		srp.log.Error().Err(err).Msg("DebitBalance: failed to build update SQL query")
+		return errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "user_repository.DebitBalance",
+		QueryRow: query,
+	}
+
+	_, err = srp.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		srp.log.Error().Err(err).Msg("DebitBalance: failed to update user balance")
+		return errresponse.ErrResponse(err)
+	}
+
+	_, err = srp.spendCoinLots(ctx, userId, amount)
+
+	return err
+}
+
+// addCoinLots создаёт партии зачисленных пользователю монет. Вызывается после
+// зачисления на users.coins. Монеты получают срок действия партий lots,
+// из которых они были списаны, а сумма сверх lots — срок по умолчанию
+// столбца coin_lots.expires_at. Если баланс был отрицательным, зачисление
+// сначала погашает долг, начиная с партий, срок которых истекает раньше,
+// и в партии попадает только положительная часть баланса.
+func (srp *ShopRepo) addCoinLots(ctx context.Context, userId int, amount int, lots []models.CoinLotSpend) error {
+	var coins int
+
+	selectQuery := squirrel.Select("coins").PlaceholderFormat(squirrel.Dollar).
+		From("users").
+		Where(squirrel.Eq{"id": userId})
+
+	query, args, err := selectQuery.ToSql()
+	if err != nil {
+		srp.log.Error().Err(err).Msg("addCoinLots: failed to build select SQL query")
+		return errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "user_repository.addCoinLots",
+		QueryRow: query,
+	}
+
+	err = srp.db.DB().QueryRowContext(ctx, queryStruct, args...).Scan(&coins)
+	if err != nil {
+		srp.log.Error().Err(err).Msg("addCoinLots: failed to select user balance")
+		return errresponse.ErrResponse(err)
+	}
+
+	debt := amount - min(amount, max(coins, 0))
+
+	insertQuery := squirrel.Insert("coin_lots").PlaceholderFormat(squirrel.Dollar).
+		Columns("user_id", "amount", "remaining", "expires_at")
+
+	rows := 0
+
+	for _, lot := range lots {
+		lotAmount := min(lot.Amount, amount)
+		amount -= lotAmount
+
+		repaid := min(lotAmount, debt)
+		lotAmount -= repaid
+		debt -= repaid
+
+		if lotAmount > 0 {
+			insertQuery = insertQuery.Values(userId, lotAmount, lotAmount, lot.ExpiresAt)
+			rows++
+		}
+	}
+
+	amount -= debt
+
+	if amount > 0 {
+		insertQuery = insertQuery.Values(userId, amount, amount, squirrel.Expr("DEFAULT"))
+		rows++
+	}
+
+	if rows == 0 {
+		return nil
+	}
+
+	query, args, err = insertQuery.ToSql()
+	if err != nil {
+		srp.log.Error().Err(err).Msg("addCoinLots: failed to build insert SQL query")
+		return errresponse.ErrResponse(err)
+	}
+
+	queryStruct = db.Query{
+		Name:     "user_repository.addCoinLots",
+		QueryRow: query,
+	}
+
+	_, err = srp.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		srp.log.Error().Err(err).Msg("addCoinLots: failed to insert coin lots")
 		return errresponse.ErrResponse(err)
 	}
 
 	return nil
 }
 
+// spendCoinLotsQuery уменьшает остатки действующих партий пользователя,
+// начиная с партий, срок которых истекает раньше, пока не будет списана
+// вся сумма, и возвращает израсходованные части партий в порядке списания.
+const spendCoinLotsQuery = `WITH spent AS (
+	UPDATE coin_lots c
+	SET remaining = c.remaining - LEAST(c.remaining, $2 - l.spent_before)
+	FROM (
+		SELECT id, remaining, COALESCE(SUM(remaining) OVER (
+			ORDER BY expires_at, id ROWS BETWEEN UNBOUNDED PRECEDING AND 1 PRECEDING), 0) AS spent_before
+		FROM coin_lots
+		WHERE user_id = $1 AND remaining > 0 AND expires_at > NOW()
+	) l
+	WHERE c.id = l.id AND l.spent_before < $2
+	RETURNING c.id AS lot_id, LEAST(l.remaining, $2 - l.spent_before) AS amount, c.expires_at
+)
+SELECT lot_id, amount, expires_at FROM spent ORDER BY expires_at, lot_id`
+
+// spendCoinLots списывает сумму с действующих партий пользователя и возвращает
+// израсходованные части партий. Вызывается после списания с users.coins,
+// поэтому строка пользователя уже заблокирована и параллельные списания
+// не могут израсходовать одни и те же партии. Просроченные партии не
+// расходуются: их остатки списываются при сгорании монет.
+func (srp *ShopRepo) spendCoinLots(ctx context.Context, userId int, amount int) ([]models.CoinLotSpend, error) {
+	var spends []models.CoinLotSpend
+
+	queryStruct := db.Query{
+		Name:     "user_repository.spendCoinLots",
+		QueryRow: spendCoinLotsQuery,
+	}
+
+	err := srp.db.DB().ScanAllContext(ctx, &spends, queryStruct, userId, amount)
+	if err != nil {
+		srp.log.Error().Err(err).Msg("spendCoinLots: failed to spend coin lots")
+		return nil, errresponse.ErrResponse(err)
+	}
+
+	return spends, nil
+}
+
+// ExpiredPendingTransferIds возвращает переводы, срок подтверждения которых истёк.
+func (srp *ShopRepo) ExpiredPendingTransferIds(ctx context.Context, limit int) ([]int, error) {
+	var ids []int
+
+	selectQuery := squirrel.Select("id").
+		PlaceholderFormat(squirrel.Dollar).
+		From("transactions").
+		Where(squirrel.Eq{"status": models.TransferStatusPending}).
+		Where("expires_at <= NOW()").
+		OrderBy("expires_at", "id").
+		Limit(uint64(limit))
+
+	query, args, err := selectQuery.ToSql()
+	if err != nil {
+		srp.log.Error().Err(err).Msg("ExpiredPendingTransferIds: failed to build select SQL query")
+		return nil, errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "user_repository.ExpiredPendingTransferIds",
+		QueryRow: query,
+	}
+
+	err = srp.db.DB().ScanAllContext(ctx, &ids, queryStruct, args...)
+	if err != nil {
+		srp.log.Error().Err(err).Msg("ExpiredPendingTransferIds: failed to scan rows")
+		return nil, errresponse.ErrResponse(err)
+	}
+
+	return ids, nil
+}
+
 func (srp *ShopRepo) GetItemsByUserId(ctx context.Context, userId int) ([]models.Items, error) {
 	var items []models.Items
 
@@ -211,7 +821,7 @@ func (srp *ShopRepo) GetItemsByUserId(ctx context.Context, userId int) ([]models
 		PlaceholderFormat(squirrel.Dollar).
 		From("purchases as pu").
 		Join("products as p ON pu.products_id = p.id").
-		Where(squirrel.Eq{"pu.user_id": userId}).
+		Where(squirrel.Eq{"pu.user_id": userId, "pu.status": models.PurchaseStatusCompleted}).
 		GroupBy("name")
 
 	query, args, err := builder.ToSql()
@@ -237,12 +847,17 @@ func (srp *ShopRepo) GetItemsByUserId(ctx context.Context, userId int) ([]models
 func (srp *ShopRepo) SentCoinsByUserId(ctx context.Context, userId int) ([]models.SentCoins, error) {
 	var sentCoins []models.SentCoins
 
-	builder := squirrel.Select("recipient.username as to_user", "SUM(t.amount) AS amount").
+	builder := squirrel.Select("recipient.username as to_user", "SUM(t.amount) AS amount", "t.status AS status").
 		PlaceholderFormat(squirrel.Dollar).
 		From("transactions t").
 		Join("users recipient ON recipient.id = t.receiver_id").
-		Where(squirrel.Eq{"t.sender_id": userId}).
-		GroupBy("recipient.username")
+		Where(squirrel.Eq{
+			"t.sender_id": userId,
+			"t.status":    []string{models.TransferStatusCompleted, models.TransferStatusPending},
+		}).
+		Where(squirrel.Expr("NOT "+transferReversedCondition)).
+		GroupBy("recipient.username", "t.status").
+		OrderBy("recipient.username", "t.status")
 
 	query, args, err := builder.ToSql()
 	if err != nil {
@@ -267,11 +882,16 @@ func (srp *ShopRepo) SentCoinsByUserId(ctx context.Context, userId int) ([]model
 func (srp *ShopRepo) ReceivedCoinsByUserId(ctx context.Context, userId int) ([]models.ReceivedCoins, error) {
 	var receivedCoins []models.ReceivedCoins
 
-	builder := squirrel.Select("sender.username AS from_user", "SUM(t.amount) AS amount").
+	builder := squirrel.Select(
+		"sender.username AS from_user",
+		"SUM(t.amount) AS amount",
+		"ARRAY_REMOVE(ARRAY_AGG(t.message ORDER BY t.id), NULL) AS messages",
+	).
 		PlaceholderFormat(squirrel.Dollar).
 		From("transactions t").
 		Join("users sender ON sender.id = t.sender_id").
-		Where(squirrel.Eq{"t.receiver_id": userId}).
+		Where(squirrel.Eq{"t.receiver_id": userId, "t.status": models.TransferStatusCompleted}).
+		Where(squirrel.Expr("NOT " + transferReversedCondition)).
 		GroupBy("sender.username")
 
 	query, args, err := builder.ToSql()
@@ -293,3 +913,264 @@ func (srp *ShopRepo) ReceivedCoinsByUserId(ctx context.Context, userId int) ([]m
 
 	return receivedCoins, nil
 }
+
+// transactionsQuery выбирает переводы, которые пользователь отправил или получил.
+func transactionsQuery(userId int) squirrel.SelectBuilder {
+	return squirrel.Select("t.id as id").
+		Column("CASE WHEN t.sender_id = ? THEN ? ELSE ? END AS direction",
+			userId, models.TransactionDirectionSent, models.TransactionDirectionReceived).
+		Columns(
+			"COALESCE(counterparty.username, '') AS counterparty",
+			"t.amount AS amount",
+			"t.message AS message",
+			transferStatusColumn+" AS status",
+			"t.expires_at AS expires_at",
+			"t.reversal_of AS reversal_of",
+			"t.created_at AS created_at",
+		).
+		PlaceholderFormat(squirrel.Dollar).
+		From("transactions t").
+		LeftJoin("users counterparty ON counterparty.id = "+
+			"CASE WHEN t.sender_id = ? THEN t.receiver_id ELSE t.sender_id END", userId).
+		Where(squirrel.Or{squirrel.Eq{"t.sender_id": userId}, squirrel.Eq{"t.receiver_id": userId}})
+}
+
+// GetTransactionByUserId возвращает перевод, который пользователь отправил или получил.
+func (srp *ShopRepo) GetTransactionByUserId(ctx context.Context, userId int, transactionId int) (
+	models.Transaction, error) {
+	var transaction models.Transaction
+
+	builder := transactionsQuery(userId).Where(squirrel.Eq{"t.id": transactionId})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		srp.log.Error().Err(err).Msg("GetTransactionByUserId: failed to build select SQL query")
+		return transaction, errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "user_repository.GetTransactionByUserId",
+		QueryRow: query,
+	}
+
+	err = srp.db.DB().ScanOneContext(ctx, &transaction, queryStruct, args...)
+	if err != nil {
+		srp.log.Error().Err(err).Msg("GetTransactionByUserId: failed to scan row")
+		return transaction, errresponse.ErrResponse(err, i18n.EntityTransfer)
+	}
+
+	return transaction, nil
+}
+
+// GetTransactionsByUserId возвращает переводы пользователя по одному, начиная с последних.
+// Страница начинается с перевода, предшествующего filter.BeforeId, если он задан.
+func (srp *ShopRepo) GetTransactionsByUserId(ctx context.Context, userId int, filter models.TransactionsFilter) (
+	[]models.Transaction, error) {
+	var transactions []models.Transaction
+
+	builder := transactionsQuery(userId).
+		OrderBy("t.id DESC").
+		Limit(uint64(filter.Limit))
+
+	if filter.BeforeId > 0 {
+		builder = builder.Where(squirrel.Lt{"t.id": filter.BeforeId})
+	}
+
+	if filter.From != nil {
+		builder = builder.Where(squirrel.GtOrEq{"t.created_at": *filter.From})
+	}
+
+	if filter.To != nil {
+		builder = builder.Where(squirrel.Lt{"t.created_at": *filter.To})
+	}
+
+	if filter.Counterparty != "" {
+		builder = builder.Where(squirrel.Eq{"counterparty.username": filter.Counterparty})
+	}
+
+	if filter.Status != "" {
+		builder = builder.Where(squirrel.Expr(transferStatusColumn+" = ?", filter.Status))
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		srp.log.Error().Err(err).Msg("GetTransactionsByUserId: failed to build select SQL query")
+		return nil, errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "user_repository.GetTransactionsByUserId",
+		QueryRow: query,
+	}
+
+	err = srp.db.DB().ScanAllContext(ctx, &transactions, queryStruct, args...)
+	if err != nil {
+		srp.log.Error().Err(err).Msg("GetTransactionsByUserId: failed to scan rows")
+		return nil, errresponse.ErrResponse(err)
+	}
+
+	return transactions, nil
+}
+
+// GetProducts возвращает страницу каталога. Товар с ограниченным остатком,
+// который закончился, показывается недоступным для покупки.
+func (srp *ShopRepo) GetProducts(ctx context.Context, filter models.ProductsFilter) ([]models.Product, error) {
+	var products []models.Product
+
+	builder := squirrel.Select("name", "price").
+		Column("available AND (stock IS NULL OR stock > 0) AS available").
+		Column("stock").
+		PlaceholderFormat(squirrel.Dollar).
+		From("products").
+		OrderBy(filter.SortBy+" "+filter.Order, "id").
+		Limit(uint64(filter.Limit)).
+		Offset(uint64(filter.Offset))
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		srp.log.Error().Err(err).Msg("GetProducts: failed to build select SQL query")
+		return nil, errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "user_repository.GetProducts",
+		QueryRow: query,
+	}
+
+	err = srp.db.DB().ScanAllContext(ctx, &products, queryStruct, args...)
+	if err != nil {
+		srp.log.Error().Err(err).Msg("GetProducts: failed to scan rows")
+		return nil, errresponse.ErrResponse(err)
+	}
+
+	return products, nil
+}
+
+// purchasesQuery выбирает покупки пользователя с названиями товаров.
+func purchasesQuery(userId int) squirrel.SelectBuilder {
+	return squirrel.Select(
+		"pu.id as id",
+		"p.name as name",
+		"pu.quantity as quantity",
+		"pu.unit_price as unit_price",
+		"pu.total_price as total_price",
+		"pu.status as status",
+		"pu.purchased_at as purchased_at",
+	).
+		PlaceholderFormat(squirrel.Dollar).
+		From("purchases as pu").
+		Join("products as p ON pu.products_id = p.id").
+		Where(squirrel.Eq{"pu.user_id": userId})
+}
+
+// GetPurchaseByUserId возвращает покупку пользователя.
+func (srp *ShopRepo) GetPurchaseByUserId(ctx context.Context, userId int, purchaseId int) (
+	models.PurchaseRecord, error) {
+	var purchase models.PurchaseRecord
+
+	builder := purchasesQuery(userId).Where(squirrel.Eq{"pu.id": purchaseId})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		srp.log.Error().Err(err).Msg("GetPurchaseByUserId: failed to build select SQL query")
+		return purchase, errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "user_repository.GetPurchaseByUserId",
+		QueryRow: query,
+	}
+
+	err = srp.db.DB().ScanOneContext(ctx, &purchase, queryStruct, args...)
+	if err != nil {
+		srp.log.Error().Err(err).Msg("GetPurchaseByUserId: failed to scan row")
+		return purchase, errresponse.ErrResponse(err, i18n.EntityPurchase)
+	}
+
+	return purchase, nil
+}
+
+func (srp *ShopRepo) GetPurchasesByUserId(ctx context.Context, userId int, filter models.PurchasesFilter) (
+	[]models.PurchaseRecord, error) {
+	var purchases []models.PurchaseRecord
+
+	builder := purchasesQuery(userId).
+		OrderBy("pu.purchased_at DESC", "pu.id DESC").
+		Limit(uint64(filter.Limit)).
+		Offset(uint64(filter.Offset))
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		srp.log.Error().Err(err).Msg("GetPurchasesByUserId: failed to build select SQL query")
+		return nil, errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "user_repository.GetPurchasesByUserId",
+		QueryRow: query,
+	}
+
+	err = srp.db.DB().ScanAllContext(ctx, &purchases, queryStruct, args...)
+	if err != nil {
+		srp.log.Error().Err(err).Msg("GetPurchasesByUserId: failed to scan rows")
+		return nil, errresponse.ErrResponse(err)
+	}
+
+	return purchases, nil
+}
+
+// PurchasesSummaryByUserId возвращает общее количество покупок пользователя
+// и сумму, потраченную на невозвращённые покупки.
+func (srp *ShopRepo) PurchasesSummaryByUserId(ctx context.Context, userId int) (total int, spent int, err error) {
+	builder := squirrel.Select("COUNT(*)").
+		Column("COALESCE(SUM(total_price) FILTER (WHERE status = ?), 0)", models.PurchaseStatusCompleted).
+		PlaceholderFormat(squirrel.Dollar).
+		From("purchases").
+		Where(squirrel.Eq{"user_id": userId})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		srp.log.Error().Err(err).Msg("PurchasesSummaryByUserId: failed to build select SQL query")
+		return 0, 0, errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "user_repository.PurchasesSummaryByUserId",
+		QueryRow: query,
+	}
+
+	err = srp.db.DB().QueryRowContext(ctx, queryStruct, args...).Scan(&total, &spent)
+	if err != nil {
+		srp.log.Error().Err(err).Msg("PurchasesSummaryByUserId: failed to count purchases")
+		return 0, 0, errresponse.ErrResponse(err)
+	}
+
+	return total, spent, nil
+}
+
+func (srp *ShopRepo) CountProducts(ctx context.Context) (int, error) {
+	var total int
+
+	builder := squirrel.Select("COUNT(*)").
+		PlaceholderFormat(squirrel.Dollar).
+		From("products")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		srp.log.Error().Err(err).Msg("CountProducts: failed to build select SQL query")
+		return 0, errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "user_repository.CountProducts",
+		QueryRow: query,
+	}
+
+	err = srp.db.DB().QueryRowContext(ctx, queryStruct, args...).Scan(&total)
+	if err != nil {
+		srp.log.Error().Err(err).Msg("CountProducts: failed to count products")
+		return 0, errresponse.ErrResponse(err)
+	}
+
+	return total, nil
+}
@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/MaksimovDenis/Avito_merch_shop/internal/client"
+	errresponse "github.com/MaksimovDenis/Avito_merch_shop/internal/err_response"
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/models"
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"github.com/rs/zerolog"
+)
+
+type TransferLimits interface {
+	GetTransferLimits(ctx context.Context, userId int) (models.TransferLimitsOverride, error)
+	SetTransferLimits(ctx context.Context, userId int, limits models.TransferLimitsOverride) error
+	GetTransferStats(ctx context.Context, senderId int) (models.TransferStats, error)
+}
+
+type TransferLimitsRepo struct {
+	db  db.Client
+	log zerolog.Logger
+}
+
+func newTransferLimitsRepository(db db.Client, log zerolog.Logger) *TransferLimitsRepo {
+	return &TransferLimitsRepo{
+		db:  db,
+		log: log,
+	}
+}
+
+// GetTransferLimits возвращает лимиты, заданные пользователю.
+// Если лимиты не заданы, возвращает пустой набор.
+func (tlr *TransferLimitsRepo) GetTransferLimits(ctx context.Context, userId int) (
+	models.TransferLimitsOverride, error) {
+	var limits models.TransferLimitsOverride
+
+	selectQuery := squirrel.Select("max_amount", "daily_limit", "weekly_limit", "hourly_count").
+		PlaceholderFormat(squirrel.Dollar).
+		From("transfer_limits").
+		Where(squirrel.Eq{"user_id": userId})
+
+	query, args, err := selectQuery.ToSql()
+	if err != nil {
+		tlr.log.Error().Err(err).Msg("GetTransferLimits: failed to build select SQL query")
+		return models.TransferLimitsOverride{}, errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "transfer_limits_repository.GetTransferLimits",
+		QueryRow: query,
+	}
+
+	err = tlr.db.DB().QueryRowContext(ctx, queryStruct, args...).Scan(
+		&limits.MaxAmount,
+		&limits.DailyLimit,
+		&limits.WeeklyLimit,
+		&limits.HourlyCount,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return models.TransferLimitsOverride{}, nil
+	}
+
+	if err != nil {
+		tlr.log.Error().Err(err).Msg("GetTransferLimits: failed to select transfer limits")
+		return models.TransferLimitsOverride{}, errresponse.ErrResponse(err)
+	}
+
+	return limits, nil
+}
+
+// SetTransferLimits заменяет лимиты пользователя. Незаданные лимиты
+// сбрасываются к значениям из конфигурации.
+func (tlr *TransferLimitsRepo) SetTransferLimits(ctx context.Context, userId int,
+	limits models.TransferLimitsOverride) error {
+	insertQuery := squirrel.Insert("transfer_limits").PlaceholderFormat(squirrel.Dollar).
+		Columns("user_id", "max_amount", "daily_limit", "weekly_limit", "hourly_count").
+		Values(userId, limits.MaxAmount, limits.DailyLimit, limits.WeeklyLimit, limits.HourlyCount).
+		Suffix("ON CONFLICT (user_id) DO UPDATE SET " +
+			"max_amount = EXCLUDED.max_amount, " +
+			"daily_limit = EXCLUDED.daily_limit, " +
+			"weekly_limit = EXCLUDED.weekly_limit, " +
+			"hourly_count = EXCLUDED.hourly_count, " +
+			"updated_at = NOW()")
+
+	query, args, err := insertQuery.ToSql()
+	if err != nil {
+		tlr.log.Error().Err(err).Msg("SetTransferLimits: failed to build insert SQL query")
+		return errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "transfer_limits_repository.SetTransferLimits",
+		QueryRow: query,
+	}
+
+	_, err = tlr.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		tlr.log.Error().Err(err).Msg("SetTransferLimits: failed to save transfer limits")
+		return errresponse.ErrResponse(err)
+	}
+
+	return nil
+}
+
+// GetTransferStats возвращает сумму переводов отправителя за последние сутки
+// и неделю и количество переводов за последний час. Отклонённые
+// просроченные и отменённые администратором переводы не учитываются:
+// монеты по ним вернулись отправителю.
+func (tlr *TransferLimitsRepo) GetTransferStats(ctx context.Context, senderId int) (models.TransferStats, error) {
+	var stats models.TransferStats
+
+	selectQuery := squirrel.Select(
+		"COALESCE(SUM(t.amount) FILTER (WHERE t.created_at > NOW() - INTERVAL '1 day'), 0)",
+		"COALESCE(SUM(t.amount), 0)",
+		"COUNT(*) FILTER (WHERE t.created_at > NOW() - INTERVAL '1 hour')",
+	).
+		PlaceholderFormat(squirrel.Dollar).
+		From("transactions t").
+		Where(squirrel.Eq{
+			"t.sender_id": senderId,
+			"t.status":    []string{models.TransferStatusCompleted, models.TransferStatusPending},
+		}).
+		Where("t.created_at > NOW() - INTERVAL '7 days'").
+		Where(squirrel.Expr("NOT " + transferReversedCondition))
+
+	query, args, err := selectQuery.ToSql()
+	if err != nil {
+		tlr.log.Error().Err(err).Msg("GetTransferStats: failed to build select SQL query")
+		return models.TransferStats{}, errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "transfer_limits_repository.GetTransferStats",
+		QueryRow: query,
+	}
+
+	err = tlr.db.DB().QueryRowContext(ctx, queryStruct, args...).Scan(
+		&stats.Daily,
+		&stats.Weekly,
+		&stats.HourlyCount,
+	)
+	if err != nil {
+		tlr.log.Error().Err(err).Msg("GetTransferStats: failed to select transfer stats")
+		return models.TransferStats{}, errresponse.ErrResponse(err)
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+
+	db "github.com/MaksimovDenis/Avito_merch_shop/internal/client"
+	errresponse "github.com/MaksimovDenis/Avito_merch_shop/internal/err_response"
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/i18n"
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/models"
+	"github.com/Masterminds/squirrel"
+	"github.com/rs/zerolog"
+)
+
+type TransferReversal interface {
+	GetTransferForReversal(ctx context.Context, transactionId int) (models.Transfer, error)
+	AddReversalTransaction(ctx context.Context, transfer models.Transfer, message string) (reversalId int, err error)
+	AddTransferReversal(ctx context.Context, reversal models.TransferReversal) (models.TransferReversal, error)
+}
+
+// Исходный перевод при отмене не изменяется: отменённым считается перевод,
+// на который ссылается встречный перевод через reversal_of.
+const (
+	transferReversedCondition = "EXISTS (SELECT 1 FROM transactions reversal WHERE reversal.reversal_of = t.id)"
+	transferStatusColumn      = "CASE WHEN " + transferReversedCondition + " THEN '" +
+		models.TransferStatusReversed + "' ELSE t.status END"
+)
+
+type TransferReversalRepo struct {
+	db  db.Client
+	log zerolog.Logger
+}
+
+func newTransferReversalRepository(db db.Client, log zerolog.Logger) *TransferReversalRepo {
+	return &TransferReversalRepo{
+		db:  db,
+		log: log,
+	}
+}
+
+// GetTransferForReversal возвращает перевод и блокирует его строку до конца транзакции.
+// Уже отменённый перевод возвращается со статусом reversed.
+func (trr *TransferReversalRepo) GetTransferForReversal(ctx context.Context, transactionId int) (
+	models.Transfer, error) {
+	var transfer models.Transfer
+
+	selectQuery := squirrel.Select(
+		"t.id", "t.sender_id", "sender.username", "t.receiver_id", "receiver.username", "t.amount",
+	).
+		Column(transferStatusColumn).
+		PlaceholderFormat(squirrel.Dollar).
+		From("transactions t").
+		Join("users sender ON sender.id = t.sender_id").
+		Join("users receiver ON receiver.id = t.receiver_id").
+		Where(squirrel.Eq{"t.id": transactionId}).
+		Suffix("FOR UPDATE OF t")
+
+	query, args, err := selectQuery.ToSql()
+	if err != nil {
+		trr.log.Error().Err(err).Msg("GetTransferForReversal: failed to build select SQL query")
+		return transfer, errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "transfer_reversal_repository.GetTransferForReversal",
+		QueryRow: query,
+	}
+
+	err = trr.db.DB().QueryRowContext(ctx, queryStruct, args...).Scan(
+		&transfer.Id,
+		&transfer.SenderId,
+		&transfer.Sender,
+		&transfer.ReceiverId,
+		&transfer.Receiver,
+		&transfer.Amount,
+		&transfer.Status,
+	)
+	if err != nil {
+		trr.log.Error().Err(err).Msg("GetTransferForReversal: failed to get transfer")
+		return transfer, errresponse.ErrResponse(err, i18n.EntityTransfer)
+	}
+
+	return transfer, nil
+}
+
+// AddReversalTransaction записывает встречный перевод от получателя
+// исходного перевода его отправителю.
+func (trr *TransferReversalRepo) AddReversalTransaction(ctx context.Context, transfer models.Transfer,
+	message string) (reversalId int, err error) {
+	insertQuery := squirrel.Insert("transactions").PlaceholderFormat(squirrel.Dollar).
+		Columns("sender_id", "receiver_id", "amount", "message", "status", "reversal_of").
+		Values(transfer.ReceiverId, transfer.SenderId, transfer.Amount, message,
+			models.TransferStatusReversal, transfer.Id).
+		Suffix("RETURNING id")
+
+	query, args, err := insertQuery.ToSql()
+	if err != nil {
+		trr.log.Error().Err(err).Msg("AddReversalTransaction: failed to build insert SQL query")
+		return 0, errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "transfer_reversal_repository.AddReversalTransaction",
+		QueryRow: query,
+	}
+
+	err = trr.db.DB().QueryRowContext(ctx, queryStruct, args...).Scan(&reversalId)
+	if err != nil {
+		trr.log.Error().Err(err).Msg("AddReversalTransaction: failed to insert transaction")
+		return 0, errresponse.ErrResponse(err)
+	}
+
+	return reversalId, nil
+}
+
+// AddTransferReversal сохраняет запись об отмене перевода для аудита.
+func (trr *TransferReversalRepo) AddTransferReversal(ctx context.Context, reversal models.TransferReversal) (
+	models.TransferReversal, error) {
+	insertQuery := squirrel.Insert("transfer_reversals").PlaceholderFormat(squirrel.Dollar).
+		Columns("transaction_id", "reversal_id", "reversed_by", "reason", "forced").
+		Values(reversal.TransactionId, reversal.ReversalId, reversal.ReversedBy, reversal.Reason, reversal.Forced).
+		Suffix("RETURNING id, created_at")
+
+	query, args, err := insertQuery.ToSql()
+	if err != nil {
+		trr.log.Error().Err(err).Msg("AddTransferReversal: failed to build insert SQL query")
+		return reversal, errresponse.ErrResponse(err)
+	}
+
+	queryStruct := db.Query{
+		Name:     "transfer_reversal_repository.AddTransferReversal",
+		QueryRow: query,
+	}
+
+	err = trr.db.DB().QueryRowContext(ctx, queryStruct, args...).Scan(&reversal.Id, &reversal.CreatedAt)
+	if err != nil {
+		trr.log.Error().Err(err).Msg("AddTransferReversal: failed to insert transfer reversal")
+		return reversal, errresponse.ErrResponse(err)
+	}
+
+	return reversal, nil
+}
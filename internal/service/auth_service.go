@@ -6,13 +6,12 @@ import (
 	"regexp"
 	"time"
 
+	errresponse "github.com/MaksimovDenis/Avito_merch_shop/internal/err_response"
 	"github.com/MaksimovDenis/Avito_merch_shop/internal/models"
 	"github.com/MaksimovDenis/Avito_merch_shop/internal/repository"
 	"github.com/MaksimovDenis/Avito_merch_shop/pkg/token"
 	"github.com/MaksimovDenis/Avito_merch_shop/pkg/util"
 	"github.com/rs/zerolog"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 const durationAccessToken time.Duration = 24 * time.Hour
@@ -53,7 +52,7 @@ func (auth *AuthService) Auth(ctx context.Context, req models.AuthReq) (string,
 
 	user, err := auth.appRepository.Authorization.GetUser(ctx, req.Username)
 	if err != nil {
-		if status.Code(err) == codes.NotFound {
+		if errors.Is(err, errresponse.ErrNotFound) {
 			accessToken, err := auth.CreateUser(ctx, req)
 			if err != nil {
 				return "", err
@@ -70,7 +69,7 @@ func (auth *AuthService) Auth(ctx context.Context, req models.AuthReq) (string,
 
 	if err = util.CheckPassword(req.Password, user.Password); err != nil {
 		auth.log.Error().Err(err).Msg("password mismatch")
-		return "", errors.New("неверный логин или пароль")
+		return "", errresponse.Unauthorized("invalid_credentials")
 	}
 
 	return auth.generateToken(user)
@@ -95,7 +94,7 @@ func (auth *AuthService) CreateUser(ctx context.Context, req models.AuthReq) (st
 }
 
 func (auth *AuthService) generateToken(user models.User) (string, error) {
-	accessToken, _, err := auth.token.CreateToken(int64(user.Id), user.Username, durationAccessToken)
+	accessToken, _, err := auth.token.CreateToken(int64(user.Id), user.Username, user.Role, durationAccessToken)
 	if err != nil {
 		auth.log.Error().Err(err).Msg("failed to create access token")
 		return "", err
@@ -107,15 +106,15 @@ func (auth *AuthService) generateToken(user models.User) (string, error) {
 func validateData(user models.AuthReq) error {
 	switch {
 	case user.Username == "":
-		return errors.New("заполните поле логин")
+		return errresponse.Validation("username_required")
 	case user.Password == "":
-		return errors.New("заполните поле пароль")
+		return errresponse.Validation("password_required")
 	case user.Username == user.Password:
-		return errors.New("логин и пароль совпадают")
+		return errresponse.Validation("password_equals_username")
 	case invalidCharsRegex.MatchString(user.Username):
-		return errors.New("логин содержит недопустимые символы")
+		return errresponse.Validation("username_invalid_chars")
 	case invalidCharsRegex.MatchString(user.Password):
-		return errors.New("пароль содержит недопустимые символы")
+		return errresponse.Validation("password_invalid_chars")
 	default:
 		return nil
 	}
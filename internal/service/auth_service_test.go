@@ -123,7 +123,7 @@ func TestAuth(t *testing.T) {
 	var token token.JWTMaker
 
 	repo := repository.NewRepository(clientDb, log)
-	authSvc := NewService(*repo, clientDb, token, log)
+	authSvc := NewService(*repo, clientDb, token, testRefundWindow, testAcceptWindow, testTransferLimits, log)
 
 	type args struct {
 		auth models.AuthReq
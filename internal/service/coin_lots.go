@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/client/db/pg"
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/models"
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	expiringCoinsWindow = 30 * 24 * time.Hour
+
+	expireCoinLotsBatch = 100
+)
+
+// ExpiringCoins возвращает партии монет пользователя, которые сгорят в ближайшие 30 дней.
+func (svc *ShopService) ExpiringCoins(ctx context.Context, userId int) ([]models.CoinLot, error) {
+	return svc.appRepository.CoinLots.ExpiringCoinLots(ctx, userId, expiringCoinsWindow)
+}
+
+// ExpireCoinLots списывает остатки партий монет, срок действия которых истёк.
+// Каждая партия обрабатывается в отдельной транзакции, ошибка одной партии
+// не мешает списать остальные. Возвращает количество списанных партий
+// и ошибки несписанных.
+func (svc *ShopService) ExpireCoinLots(ctx context.Context) (int, error) {
+	ids, err := svc.appRepository.CoinLots.ExpiredCoinLotIds(ctx, expireCoinLotsBatch)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		expired int
+		errs    []error
+	)
+
+	for _, id := range ids {
+		var done bool
+
+		err := svc.retryTx(ctx, func() error {
+			var err error
+
+			done, err = svc.expireCoinLot(ctx, id)
+
+			return err
+		})
+		if err != nil {
+			svc.log.Error().Err(err).Int("lotId", id).Msg("failed to expire coin lot")
+			errs = append(errs, fmt.Errorf("coin lot %d: %w", id, err))
+
+			continue
+		}
+
+		if done {
+			expired++
+		}
+	}
+
+	return expired, errors.Join(errs...)
+}
+
+// expireCoinLot списывает остаток просроченной партии и записывает проводки в журнал.
+// Партия могла быть израсходована после выборки, поэтому остаток
+// проверяется повторно под блокировкой пользователя.
+func (svc *ShopService) expireCoinLot(ctx context.Context, lotId int) (bool, error) {
+	tx, err := svc.client.DB().BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		svc.log.Error().Err(err).Msg("failed to start transaction")
+		return false, err
+	}
+
+	ctx = pg.MakeContextTx(ctx, tx)
+
+	lot, err := svc.appRepository.CoinLots.WriteOffCoinLot(ctx, lotId)
+	if err != nil {
+		_ = tx.Rollback(ctx)
+		return false, err
+	}
+
+	if lot.Remaining == 0 {
+		_ = tx.Rollback(ctx)
+		return false, nil
+	}
+
+	err = svc.appRepository.Ledger.PostJournal(ctx, coinExpirationJournal(lot.UserId, lot.Id, lot.Remaining))
+	if err != nil {
+		_ = tx.Rollback(ctx)
+		return false, err
+	}
+
+	return true, tx.Commit(ctx)
+}
+
+// expireUserCoinLots списывает просроченные остатки партий пользователя
+// и записывает проводки в журнал. Вызывается внутри транзакции перед
+// списанием монет, чтобы сгоревшие монеты нельзя было потратить до запуска
+// фоновой задачи. Возвращает списанную сумму.
+func (svc *ShopService) expireUserCoinLots(ctx context.Context, userId int) (int, error) {
+	lots, err := svc.appRepository.CoinLots.WriteOffExpiredCoinLots(ctx, userId)
+	if err != nil {
+		return 0, err
+	}
+
+	expired := 0
+
+	for _, lot := range lots {
+		err := svc.appRepository.Ledger.PostJournal(ctx, coinExpirationJournal(lot.UserId, lot.Id, lot.Remaining))
+		if err != nil {
+			return 0, err
+		}
+
+		expired += lot.Remaining
+	}
+
+	return expired, nil
+}
+
+// splitCoinLotSpends делит израсходованные части партий: первые amount монет
+// в порядке списания и остаток.
+func splitCoinLotSpends(spends []models.CoinLotSpend, amount int) (head, tail []models.CoinLotSpend) {
+	for i, spend := range spends {
+		if amount == 0 {
+			return head, spends[i:]
+		}
+
+		if spend.Amount > amount {
+			head = append(head, models.CoinLotSpend{LotId: spend.LotId, Amount: amount, ExpiresAt: spend.ExpiresAt})
+			spend.Amount -= amount
+
+			return head, append([]models.CoinLotSpend{spend}, spends[i+1:]...)
+		}
+
+		head = append(head, spend)
+		amount -= spend.Amount
+	}
+
+	return head, nil
+}
@@ -0,0 +1,28 @@
+package service
+
+import (
+	"encoding/base64"
+	"strconv"
+
+	errresponse "github.com/MaksimovDenis/Avito_merch_shop/internal/err_response"
+)
+
+// encodeCursor упаковывает идентификатор последней записи страницы в непрозрачный курсор.
+func encodeCursor(id int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(id)))
+}
+
+// decodeCursor извлекает идентификатор записи из курсора, полученного от encodeCursor.
+func decodeCursor(cursor string) (int, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, errresponse.Validation("invalid_cursor")
+	}
+
+	id, err := strconv.Atoi(string(raw))
+	if err != nil || id <= 0 {
+		return 0, errresponse.Validation("invalid_cursor")
+	}
+
+	return id, nil
+}
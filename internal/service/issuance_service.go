@@ -0,0 +1,229 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	db "github.com/MaksimovDenis/Avito_merch_shop/internal/client"
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/client/db/pg"
+	errresponse "github.com/MaksimovDenis/Avito_merch_shop/internal/err_response"
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/models"
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/repository"
+	"github.com/jackc/pgx/v4"
+	"github.com/rs/zerolog"
+)
+
+type Issuance interface {
+	IssueCoins(ctx context.Context, issuedBy string, file io.Reader, dryRun bool) (models.IssuanceReport, error)
+}
+
+const (
+	maxIssuanceRows   = 10000
+	maxIssuanceAmount = 1000000
+	maxIssuanceReason = 255
+)
+
+type IssuanceService struct {
+	appRepository repository.Repository
+	client        db.Client
+	log           zerolog.Logger
+}
+
+func newIssuanceService(
+	appRepository repository.Repository,
+	client db.Client,
+	log zerolog.Logger,
+) *IssuanceService {
+	return &IssuanceService{
+		appRepository: appRepository,
+		client:        client,
+		log:           log,
+	}
+}
+
+// IssueCoins начисляет монеты пользователям из CSV-файла со строками username,amount,reason.
+// 1. Разбираем и проверяем все строки файла до каких-либо изменений.
+// 2. В транзакции блокируем получателей и отмечаем строки с неизвестными пользователями.
+// 3. Если в файле есть ошибки или это пробный запуск, откатываем транзакцию и возвращаем отчёт.
+// 4. Иначе начисляем монеты, сохраняем начисления и проводки и фиксируем транзакцию.
+func (svc *IssuanceService) IssueCoins(ctx context.Context, issuedBy string, file io.Reader,
+	dryRun bool) (models.IssuanceReport, error) {
+	rows, err := parseIssuanceCSV(file)
+	if err != nil {
+		return models.IssuanceReport{}, err
+	}
+
+	tx, err := svc.client.DB().BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		svc.log.Error().Err(err).Msg("failed to start transaction")
+		return models.IssuanceReport{}, err
+	}
+
+	ctx = pg.MakeContextTx(ctx, tx)
+
+	users, err := svc.appRepository.Shop.LockUsersByName(ctx, issuanceUsernames(rows)...)
+	if err != nil {
+		_ = tx.Rollback(ctx)
+		return models.IssuanceReport{}, err
+	}
+
+	userIds := make(map[string]int, len(users))
+	for _, user := range users {
+		userIds[user.Username] = user.Id
+	}
+
+	for i := range rows {
+		if _, ok := userIds[rows[i].Username]; rows[i].Error == nil && !ok {
+			rows[i].Error = errresponse.NotFound("issuance_user_not_found", rows[i].Username)
+		}
+	}
+
+	report := newIssuanceReport(rows, dryRun)
+	if report.Errors > 0 || dryRun {
+		_ = tx.Rollback(ctx)
+		return report, nil
+	}
+
+	for _, row := range rows {
+		userId := userIds[row.Username]
+
+		if err := svc.appRepository.Shop.CreditBalance(ctx, userId, row.Amount, nil); err != nil {
+			_ = tx.Rollback(ctx)
+			return models.IssuanceReport{}, err
+		}
+
+		issuanceId, err := svc.appRepository.Issuance.InsertIssuance(ctx, userId, row.Amount, row.Reason, issuedBy)
+		if err != nil {
+			_ = tx.Rollback(ctx)
+			return models.IssuanceReport{}, err
+		}
+
+		err = svc.appRepository.Ledger.PostJournal(ctx, issuanceJournal(userId, issuanceId, row.Amount))
+		if err != nil {
+			_ = tx.Rollback(ctx)
+			return models.IssuanceReport{}, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		svc.log.Error().Err(err).Msg("failed to commit transaction")
+		return models.IssuanceReport{}, err
+	}
+
+	report.Applied = true
+
+	return report, nil
+}
+
+// parseIssuanceCSV разбирает файл начислений. Ошибки отдельных строк
+// записываются в строку отчёта, ошибка возвращается только если файл
+// невозможно прочитать целиком.
+func parseIssuanceCSV(file io.Reader) ([]models.IssuanceRow, error) {
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var rows []models.IssuanceRow
+
+	for first := true; ; first = false {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, errresponse.Validation("invalid_csv", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+
+		if first {
+			record[0] = strings.TrimPrefix(record[0], "\ufeff")
+			if isIssuanceHeader(record) {
+				continue
+			}
+		}
+
+		if len(rows) == maxIssuanceRows {
+			return nil, errresponse.Validation("issuance_too_many_rows", maxIssuanceRows)
+		}
+
+		rows = append(rows, parseIssuanceRow(line, record))
+	}
+
+	if len(rows) == 0 {
+		return nil, errresponse.Validation("issuance_empty")
+	}
+
+	return rows, nil
+}
+
+func isIssuanceHeader(record []string) bool {
+	return len(record) == 3 &&
+		strings.EqualFold(strings.TrimSpace(record[0]), "username") &&
+		strings.EqualFold(strings.TrimSpace(record[1]), "amount") &&
+		strings.EqualFold(strings.TrimSpace(record[2]), "reason")
+}
+
+func parseIssuanceRow(line int, record []string) models.IssuanceRow {
+	row := models.IssuanceRow{Line: line}
+
+	if len(record) != 3 {
+		row.Error = errresponse.Validation("issuance_field_count", len(record))
+		return row
+	}
+
+	row.Username = strings.TrimSpace(record[0])
+	row.Reason = strings.TrimSpace(record[2])
+
+	amount, err := strconv.Atoi(strings.TrimSpace(record[1]))
+
+	switch {
+	case row.Username == "":
+		row.Error = errresponse.Validation("issuance_username_required")
+	case err != nil:
+		row.Error = errresponse.Validation("issuance_amount_not_integer")
+	case amount <= 0:
+		row.Error = errresponse.Validation("issuance_amount_not_positive")
+	case amount > maxIssuanceAmount:
+		row.Error = errresponse.Validation("issuance_amount_too_large", maxIssuanceAmount)
+	case utf8.RuneCountInString(row.Reason) > maxIssuanceReason:
+		row.Error = errresponse.Validation("issuance_reason_too_long", maxIssuanceReason)
+	}
+
+	row.Amount = amount
+
+	return row
+}
+
+func issuanceUsernames(rows []models.IssuanceRow) []string {
+	usernames := make([]string, 0, len(rows))
+	for _, row := range rows {
+		if row.Error == nil {
+			usernames = append(usernames, row.Username)
+		}
+	}
+
+	return usernames
+}
+
+func newIssuanceReport(rows []models.IssuanceRow, dryRun bool) models.IssuanceReport {
+	report := models.IssuanceReport{
+		DryRun: dryRun,
+		Total:  len(rows),
+		Rows:   rows,
+	}
+
+	for _, row := range rows {
+		if row.Error != nil {
+			report.Errors++
+		}
+	}
+
+	return report
+}
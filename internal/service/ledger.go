@@ -0,0 +1,121 @@
+package service
+
+import "github.com/MaksimovDenis/Avito_merch_shop/internal/models"
+
+// userEntry возвращает проводку по счёту пользователя.
+func userEntry(userId int, amount int) models.LedgerEntry {
+	return models.LedgerEntry{Account: models.LedgerAccountUser, UserId: &userId, Amount: amount}
+}
+
+// systemEntry возвращает проводку по системному счёту.
+func systemEntry(account string, amount int) models.LedgerEntry {
+	return models.LedgerEntry{Account: account, Amount: amount}
+}
+
+// purchaseJournal списывает стоимость покупки со счёта пользователя в выручку магазина.
+func purchaseJournal(userId int, purchaseId int, total int) models.Journal {
+	return models.Journal{
+		Operation:   models.JournalPurchase,
+		ReferenceId: purchaseId,
+		Entries: []models.LedgerEntry{
+			userEntry(userId, -total),
+			systemEntry(models.LedgerAccountShop, total),
+		},
+	}
+}
+
+// refundJournal возвращает стоимость покупки из выручки магазина на счёт пользователя.
+func refundJournal(userId int, purchaseId int, total int) models.Journal {
+	return models.Journal{
+		Operation:   models.JournalRefund,
+		ReferenceId: purchaseId,
+		Entries: []models.LedgerEntry{
+			systemEntry(models.LedgerAccountShop, -total),
+			userEntry(userId, total),
+		},
+	}
+}
+
+// transferJournal переводит монеты со счёта отправителя на счёт получателя.
+func transferJournal(senderId int, receiverId int, transactionId int, amount int) models.Journal {
+	return models.Journal{
+		Operation:   models.JournalTransfer,
+		ReferenceId: transactionId,
+		Entries: []models.LedgerEntry{
+			userEntry(senderId, -amount),
+			userEntry(receiverId, amount),
+		},
+	}
+}
+
+// transferHoldJournal удерживает монеты отправителя до подтверждения перевода.
+func transferHoldJournal(senderId int, transactionId int, amount int) models.Journal {
+	return models.Journal{
+		Operation:   models.JournalTransferHold,
+		ReferenceId: transactionId,
+		Entries: []models.LedgerEntry{
+			userEntry(senderId, -amount),
+			systemEntry(models.LedgerAccountEscrow, amount),
+		},
+	}
+}
+
+// transferAcceptJournal зачисляет удержанные монеты получателю.
+func transferAcceptJournal(receiverId int, transactionId int, amount int) models.Journal {
+	return models.Journal{
+		Operation:   models.JournalTransferAccept,
+		ReferenceId: transactionId,
+		Entries: []models.LedgerEntry{
+			systemEntry(models.LedgerAccountEscrow, -amount),
+			userEntry(receiverId, amount),
+		},
+	}
+}
+
+// transferReleaseJournal возвращает удержанные монеты отправителю.
+func transferReleaseJournal(senderId int, transactionId int, amount int) models.Journal {
+	return models.Journal{
+		Operation:   models.JournalTransferRelease,
+		ReferenceId: transactionId,
+		Entries: []models.LedgerEntry{
+			systemEntry(models.LedgerAccountEscrow, -amount),
+			userEntry(senderId, amount),
+		},
+	}
+}
+
+// issuanceJournal начисляет пользователю монеты с системного счёта выпуска.
+func issuanceJournal(userId int, issuanceId int, amount int) models.Journal {
+	return models.Journal{
+		Operation:   models.JournalIssuance,
+		ReferenceId: issuanceId,
+		Entries: []models.LedgerEntry{
+			systemEntry(models.LedgerAccountIssuance, -amount),
+			userEntry(userId, amount),
+		},
+	}
+}
+
+// coinExpirationJournal списывает со счёта пользователя сгоревший остаток партии монет.
+func coinExpirationJournal(userId int, lotId int, amount int) models.Journal {
+	return models.Journal{
+		Operation:   models.JournalCoinExpiration,
+		ReferenceId: lotId,
+		Entries: []models.LedgerEntry{
+			userEntry(userId, -amount),
+			systemEntry(models.LedgerAccountExpired, amount),
+		},
+	}
+}
+
+// transferReversalJournal возвращает монеты отменённого перевода со счёта получателя отправителю.
+func transferReversalJournal(transfer models.Transfer, reversalId int) models.Journal {
+	return models.Journal{
+		Operation:   models.JournalTransferReversal,
+		ReferenceId: reversalId,
+		Entries: []models.LedgerEntry{
+			userEntry(transfer.ReceiverId, -transfer.Amount),
+			userEntry(transfer.SenderId, transfer.Amount),
+		},
+	}
+}
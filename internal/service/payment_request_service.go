@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	db "github.com/MaksimovDenis/Avito_merch_shop/internal/client"
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/client/db/pg"
+	errresponse "github.com/MaksimovDenis/Avito_merch_shop/internal/err_response"
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/i18n"
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/models"
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/repository"
+	"github.com/jackc/pgx/v4"
+	"github.com/rs/zerolog"
+)
+
+type PaymentRequest interface {
+	CreatePaymentRequest(ctx context.Context, requesterId int, payer string, amount int, reason string) (int, error)
+	IncomingPaymentRequests(ctx context.Context, payerId int, filter models.PaymentRequestsFilter) (
+		[]models.PaymentRequest, error)
+	ApprovePaymentRequest(ctx context.Context, payerId int, requestId int) error
+	RejectPaymentRequest(ctx context.Context, payerId int, requestId int) error
+}
+
+const (
+	defaultPaymentRequestsLimit = 20
+	maxPaymentRequestsLimit     = 100
+)
+
+type PaymentRequestService struct {
+	appRepository repository.Repository
+	client        db.Client
+	shop          *ShopService
+	log           zerolog.Logger
+}
+
+func newPaymentRequestService(
+	appRepository repository.Repository,
+	client db.Client,
+	shop *ShopService,
+	log zerolog.Logger,
+) *PaymentRequestService {
+	return &PaymentRequestService{
+		appRepository: appRepository,
+		client:        client,
+		shop:          shop,
+		log:           log,
+	}
+}
+
+// CreatePaymentRequest создаёт запрос монет у другого пользователя.
+// 1. Проверяем сумму и причину запроса.
+// 2. Находим плательщика и проверяем, что он не совпадает с автором запроса.
+// 3. Сохраняем запрос в базе данных.
+func (svc *PaymentRequestService) CreatePaymentRequest(ctx context.Context, requesterId int, payer string,
+	amount int, reason string) (int, error) {
+	if amount <= 0 {
+		return 0, errresponse.Validation("invalid_request_amount")
+	}
+
+	reason = strings.TrimSpace(reason)
+	if utf8.RuneCountInString(reason) > maxTransferMessageLength {
+		return 0, errresponse.Validation("request_reason_too_long")
+	}
+
+	payerId, _, err := svc.appRepository.Shop.UserBalanceByName(ctx, payer)
+	if err != nil {
+		return 0, err
+	}
+
+	if payerId == requesterId {
+		return 0, errresponse.Validation("self_payment_request")
+	}
+
+	return svc.appRepository.PaymentRequest.CreatePaymentRequest(ctx, requesterId, payerId, amount, reason)
+}
+
+// IncomingPaymentRequests возвращает запросы монет, адресованные пользователю, начиная с последних.
+func (svc *PaymentRequestService) IncomingPaymentRequests(ctx context.Context, payerId int,
+	filter models.PaymentRequestsFilter) ([]models.PaymentRequest, error) {
+	switch {
+	case filter.Limit == 0:
+		filter.Limit = defaultPaymentRequestsLimit
+	case filter.Limit < 0 || filter.Limit > maxPaymentRequestsLimit:
+		return nil, errresponse.Validation("invalid_page_size")
+	}
+
+	if filter.Offset < 0 {
+		return nil, errresponse.Validation("invalid_offset")
+	}
+
+	switch filter.Status {
+	case "", models.PaymentRequestStatusPending, models.PaymentRequestStatusApproved,
+		models.PaymentRequestStatusRejected, models.PaymentRequestStatusReversed:
+	default:
+		return nil, errresponse.Validation("invalid_request_status")
+	}
+
+	return svc.appRepository.PaymentRequest.GetPaymentRequestsByPayerId(ctx, payerId, filter)
+}
+
+// ApprovePaymentRequest одобряет запрос монет.
+// 1. Начинаем транзакцию в БД и блокируем запрос.
+// 2. Проверяем, что запрос адресован пользователю и ещё не обработан.
+// 3. Переводим монеты автору запроса так же, как SendCoins, с причиной запроса в сообщении.
+// 4. Помечаем запрос одобренным и связываем его с переводом.
+// 5. Фиксируем транзакцию или откатываем при ошибке.
+// При ошибке сериализации или взаимной блокировке транзакция повторяется.
+func (svc *PaymentRequestService) ApprovePaymentRequest(ctx context.Context, payerId int, requestId int) error {
+	return svc.shop.retryTx(ctx, func() error {
+		return svc.resolvePaymentRequest(ctx, payerId, requestId, models.PaymentRequestStatusApproved)
+	})
+}
+
+// RejectPaymentRequest отклоняет запрос монет без перевода.
+func (svc *PaymentRequestService) RejectPaymentRequest(ctx context.Context, payerId int, requestId int) error {
+	return svc.shop.retryTx(ctx, func() error {
+		return svc.resolvePaymentRequest(ctx, payerId, requestId, models.PaymentRequestStatusRejected)
+	})
+}
+
+func (svc *PaymentRequestService) resolvePaymentRequest(ctx context.Context, payerId int, requestId int,
+	status string) error {
+	tx, err := svc.client.DB().BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		svc.log.Error().Err(err).Msg("failed to start transaction")
+		return err
+	}
+
+	ctx = pg.MakeContextTx(ctx, tx)
+
+	request, err := svc.appRepository.PaymentRequest.GetPaymentRequest(ctx, requestId)
+	if err != nil {
+		_ = tx.Rollback(ctx)
+		return err
+	}
+
+	if request.PayerId != payerId {
+		_ = tx.Rollback(ctx)
+		return errresponse.ErrResponse(pgx.ErrNoRows, i18n.EntityPaymentRequest)
+	}
+
+	if request.Status != models.PaymentRequestStatusPending {
+		_ = tx.Rollback(ctx)
+		return errresponse.Conflict("request_already_resolved")
+	}
+
+	var transactionId *int
+
+	if status == models.PaymentRequestStatusApproved {
+		var reason string
+		if request.Reason != nil {
+			reason = *request.Reason
+		}
+
+		id, err := svc.shop.sendCoinsTx(ctx, request.Payer, request.Requester, request.Amount, reason, "", false)
+		if err != nil {
+			_ = tx.Rollback(ctx)
+			return err
+		}
+
+		transactionId = &id
+	}
+
+	err = svc.appRepository.PaymentRequest.ResolvePaymentRequest(ctx, request.Id, status, transactionId)
+	if err != nil {
+		_ = tx.Rollback(ctx)
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	errresponse "github.com/MaksimovDenis/Avito_merch_shop/internal/err_response"
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/models"
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/repository"
+	"github.com/rs/zerolog"
+)
+
+type Product interface {
+	CreateProduct(ctx context.Context, product models.Product) (models.Product, error)
+	UpdateProduct(ctx context.Context, name string, update models.ProductUpdate) (models.Product, error)
+	RetireProduct(ctx context.Context, name string) (models.Product, error)
+}
+
+type ProductService struct {
+	appRepository repository.Repository
+	log           zerolog.Logger
+}
+
+func newProductService(
+	appRepository repository.Repository,
+	log zerolog.Logger,
+) *ProductService {
+	return &ProductService{
+		appRepository: appRepository,
+		log:           log,
+	}
+}
+
+// CreateProduct добавляет новый товар в каталог.
+// 1. Валидируем название и цену.
+// 2. Сохраняем товар в базе данных.
+func (svc *ProductService) CreateProduct(ctx context.Context, product models.Product) (models.Product, error) {
+	product.Name = strings.TrimSpace(product.Name)
+
+	if err := validateProduct(&product.Name, &product.Price, product.Stock); err != nil {
+		return models.Product{}, err
+	}
+
+	return svc.appRepository.Product.CreateProduct(ctx, product)
+}
+
+// UpdateProduct изменяет название, цену, доступность или остаток товара.
+// 1. Проверяем, что передано хотя бы одно поле, и валидируем его.
+// 2. Обновляем товар в базе данных.
+func (svc *ProductService) UpdateProduct(ctx context.Context, name string, update models.ProductUpdate) (
+	models.Product, error) {
+	if update.Name == nil && update.Price == nil && update.Available == nil &&
+		update.Stock == nil && !update.UnlimitedStock {
+		return models.Product{}, errresponse.Validation("no_fields_to_update")
+	}
+
+	if update.Stock != nil && update.UnlimitedStock {
+		return models.Product{}, errresponse.Validation("stock_conflict")
+	}
+
+	if update.Name != nil {
+		trimmed := strings.TrimSpace(*update.Name)
+		update.Name = &trimmed
+	}
+
+	if err := validateProduct(update.Name, update.Price, update.Stock); err != nil {
+		return models.Product{}, err
+	}
+
+	return svc.appRepository.Product.UpdateProduct(ctx, name, update)
+}
+
+// RetireProduct снимает товар с продажи.
+// Товар не удаляется, чтобы не потерять историю покупок.
+func (svc *ProductService) RetireProduct(ctx context.Context, name string) (models.Product, error) {
+	available := false
+
+	return svc.appRepository.Product.UpdateProduct(ctx, name, models.ProductUpdate{Available: &available})
+}
+
+func validateProduct(name *string, price *int, stock *int) error {
+	switch {
+	case name != nil && *name == "":
+		return errresponse.Validation("product_name_required")
+	case name != nil && invalidCharsRegex.MatchString(*name):
+		return errresponse.Validation("product_name_invalid_chars")
+	case price != nil && *price < 0:
+		return errresponse.Validation("negative_price")
+	case stock != nil && *stock < 0:
+		return errresponse.Validation("negative_stock")
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/models"
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/repository"
+	"github.com/rs/zerolog"
+)
+
+type Reconciliation interface {
+	Reconcile(ctx context.Context) (models.ReconciliationReport, error)
+}
+
+type ReconciliationService struct {
+	appRepository repository.Repository
+	log           zerolog.Logger
+}
+
+func newReconciliationService(
+	appRepository repository.Repository,
+	log zerolog.Logger,
+) *ReconciliationService {
+	return &ReconciliationService{
+		appRepository: appRepository,
+		log:           log,
+	}
+}
+
+// Reconcile сверяет балансы пользователей с историей операций.
+// 1. Получаем текущий и восстановленный по истории баланс каждого пользователя.
+// 2. Собираем в отчёт пользователей, у которых балансы расходятся.
+func (svc *ReconciliationService) Reconcile(ctx context.Context) (models.ReconciliationReport, error) {
+	report := models.ReconciliationReport{
+		CheckedAt:     time.Now().UTC(),
+		Discrepancies: []models.BalanceDiscrepancy{},
+	}
+
+	balances, err := svc.appRepository.Reconciliation.GetExpectedBalances(ctx)
+	if err != nil {
+		return models.ReconciliationReport{}, err
+	}
+
+	report.UsersChecked = len(balances)
+
+	for _, balance := range balances {
+		if balance.Coins == balance.Expected {
+			continue
+		}
+
+		report.Discrepancies = append(report.Discrepancies, models.BalanceDiscrepancy{
+			UserId:     balance.UserId,
+			Username:   balance.Username,
+			Coins:      balance.Coins,
+			Expected:   balance.Expected,
+			Difference: balance.Coins - balance.Expected,
+		})
+	}
+
+	return report, nil
+}
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	errresponse "github.com/MaksimovDenis/Avito_merch_shop/internal/err_response"
+	"github.com/jackc/pgconn"
+)
+
+const (
+	maxTxAttempts = 3
+	txRetryDelay  = 10 * time.Millisecond
+
+	serializationFailureCode = "40001"
+	deadlockDetectedCode     = "40P01"
+)
+
+// retryTx выполняет транзакцию fn и повторяет её при ошибке сериализации
+// или взаимной блокировке, но не более maxTxAttempts раз.
+func (svc *ShopService) retryTx(ctx context.Context, fn func() error) error {
+	var err error
+
+	for attempt := 1; attempt <= maxTxAttempts; attempt++ {
+		err = fn()
+		if err == nil || !isRetryableTxError(err) {
+			return err
+		}
+
+		svc.log.Warn().Err(err).Int("attempt", attempt).Msg("transaction conflict, retrying")
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(txRetryDelay * time.Duration(attempt)):
+		}
+	}
+
+	return errresponse.Conflict("concurrent_update")
+}
+
+func isRetryableTxError(err error) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+
+	return pgErr.Code == serializationFailureCode || pgErr.Code == deadlockDetectedCode
+}
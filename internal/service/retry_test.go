@@ -0,0 +1,30 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	errresponse "github.com/MaksimovDenis/Avito_merch_shop/internal/err_response"
+	"github.com/jackc/pgconn"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsRetryableTxError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "Ошибка сериализации", err: &pgconn.PgError{Code: "40001"}, want: true},
+		{name: "Взаимная блокировка", err: &pgconn.PgError{Code: "40P01"}, want: true},
+		{name: "Ошибка из репозитория", err: errresponse.ErrResponse(&pgconn.PgError{Code: "40P01"}), want: true},
+		{name: "Нарушение ограничения", err: &pgconn.PgError{Code: "23514"}, want: false},
+		{name: "Прочая ошибка", err: errors.New("ошибка"), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isRetryableTxError(tt.err))
+		})
+	}
+}
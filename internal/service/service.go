@@ -1,7 +1,10 @@
 package service
 
 import (
+	"time"
+
 	db "github.com/MaksimovDenis/Avito_merch_shop/internal/client"
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/models"
 	"github.com/MaksimovDenis/Avito_merch_shop/internal/repository"
 	"github.com/MaksimovDenis/Avito_merch_shop/pkg/token"
 	"github.com/rs/zerolog"
@@ -10,11 +13,33 @@ import (
 type Service struct {
 	Authorization
 	Shop
+	Product
+	Reconciliation
+	PaymentRequest
+	TransferLimits
+	Issuance
+	TransferReversal
 }
 
-func NewService(repos repository.Repository, client db.Client, token token.JWTMaker, log zerolog.Logger) *Service {
+func NewService(
+	repos repository.Repository,
+	client db.Client,
+	token token.JWTMaker,
+	refundWindow time.Duration,
+	acceptWindow time.Duration,
+	transferLimits models.TransferLimits,
+	log zerolog.Logger,
+) *Service {
+	shop := newShopService(repos, client, refundWindow, acceptWindow, transferLimits, log)
+
 	return &Service{
-		Authorization: newAuthService(repos, token, log),
-		Shop:          newShopService(repos, client, log),
+		Authorization:    newAuthService(repos, token, log),
+		Shop:             shop,
+		Product:          newProductService(repos, log),
+		Reconciliation:   newReconciliationService(repos, log),
+		PaymentRequest:   newPaymentRequestService(repos, client, shop, log),
+		TransferLimits:   newTransferLimitsService(repos, transferLimits, log),
+		Issuance:         newIssuanceService(repos, client, log),
+		TransferReversal: newTransferReversalService(repos, client, shop, log),
 	}
 }
@@ -3,9 +3,15 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	db "github.com/MaksimovDenis/Avito_merch_shop/internal/client"
 	"github.com/MaksimovDenis/Avito_merch_shop/internal/client/db/pg"
+	errresponse "github.com/MaksimovDenis/Avito_merch_shop/internal/err_response"
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/i18n"
 	"github.com/MaksimovDenis/Avito_merch_shop/internal/models"
 	"github.com/MaksimovDenis/Avito_merch_shop/internal/repository"
 	"github.com/jackc/pgx/v4"
@@ -13,8 +19,19 @@ import (
 )
 
 type Shop interface {
-	BuyItem(ctx context.Context, userId int, productName string) error
-	SendCoins(ctx context.Context, sender string, receiver string, amount int) error
+	BuyItem(ctx context.Context, userId int, productName string, quantity int, idempotencyKey string) (
+		purchaseId int, err error)
+	SendCoins(ctx context.Context, sender string, receiver string, amount int, message string,
+		idempotencyKey string) (transactionId int, err error)
+	SendCoinsPending(ctx context.Context, sender string, receiver string, amount int, message string,
+		idempotencyKey string) (transactionId int, err error)
+	AcceptTransfer(ctx context.Context, userId int, transactionId int) error
+	DeclineTransfer(ctx context.Context, userId int, transactionId int) error
+	ExpirePendingTransfers(ctx context.Context) (int, error)
+	ExpiringCoins(ctx context.Context, userId int) ([]models.CoinLot, error)
+	ExpireCoinLots(ctx context.Context) (int, error)
+	SendCoinsBatch(ctx context.Context, sender string, recipients []models.TransferRecipient, message string,
+		idempotencyKey string) ([]int, error)
 	Info(ctx context.Context, username string) (
 		coins int,
 		items []models.Items,
@@ -22,105 +39,403 @@ type Shop interface {
 		receivedCoins []models.ReceivedCoins,
 		err error,
 	)
+	AddToCart(ctx context.Context, userId int, productName string, quantity int) error
+	RemoveFromCart(ctx context.Context, userId int, productName string) error
+	Cart(ctx context.Context, userId int) (items []models.CartItem, total int, err error)
+	Checkout(ctx context.Context, userId int) error
+	RefundPurchase(ctx context.Context, userId int, purchaseId int) error
+	Purchase(ctx context.Context, userId int, purchaseId int) (models.PurchaseRecord, error)
+	Purchases(ctx context.Context, userId int, filter models.PurchasesFilter) (
+		purchases []models.PurchaseRecord,
+		total int,
+		spent int,
+		err error,
+	)
+	SentTransfer(ctx context.Context, userId int, transactionId int) (models.Transaction, error)
+	Transactions(ctx context.Context, userId int, cursor string, filter models.TransactionsFilter) (
+		transactions []models.Transaction,
+		nextCursor string,
+		err error,
+	)
+	Products(ctx context.Context, filter models.ProductsFilter) (products []models.Product, total int, err error)
 }
 
+const (
+	defaultProductsLimit = 20
+	maxProductsLimit     = 100
+
+	defaultPurchasesLimit = 20
+	maxPurchasesLimit     = 100
+
+	maxTransferMessageLength = 255
+
+	maxBatchRecipients = 100
+
+	expirePendingTransfersBatch = 100
+
+	defaultTransactionsLimit = 20
+	maxTransactionsLimit     = 100
+
+	maxIdempotencyKeyLength = 255
+)
+
 type ShopService struct {
 	appRepository repository.Repository
 	client        db.Client
+	refundWindow  time.Duration
+	acceptWindow  time.Duration
+	limits        models.TransferLimits
 	log           zerolog.Logger
 }
 
 func newShopService(
 	appRepository repository.Repository,
 	client db.Client,
+	refundWindow time.Duration,
+	acceptWindow time.Duration,
+	limits models.TransferLimits,
 	log zerolog.Logger,
 ) *ShopService {
 	return &ShopService{
 		appRepository: appRepository,
 		client:        client,
+		refundWindow:  refundWindow,
+		acceptWindow:  acceptWindow,
+		limits:        limits,
 		log:           log,
 	}
 }
 
 // BuyItem обрабатывает покупку товара пользователем.
-// 1. Начинаем транзакцию в БД.
-// 2. Обновляем баланс пользователя при покупке товара.
-// 3. Записываем информацию о покупке в базу данных.
-// 4. Фиксируем транзакцию или откатываем при ошибке.
-func (svc *ShopService) BuyItem(ctx context.Context, userId int, productName string) error {
+// 1. Проверяем, что количество товара положительное.
+// 2. Начинаем транзакцию в БД.
+// 3. Сохраняем ключ идемпотентности, если он передан. Если покупка
+// с этим ключом уже выполнена, повторно её не выполняем.
+// 4. Списываем просроченные монеты и стоимость всех единиц товара с баланса пользователя.
+// 5. Записываем информацию о покупке (с количеством, уплаченной ценой
+// и израсходованными партиями монет) в базу данных.
+// 6. Записываем проводки покупки в журнал.
+// 7. Фиксируем транзакцию или откатываем при ошибке.
+// Возвращает идентификатор покупки, в том числе для повторного запроса.
+func (svc *ShopService) BuyItem(ctx context.Context, userId int, productName string, quantity int,
+	idempotencyKey string) (int, error) {
+	if quantity <= 0 {
+		return 0, errresponse.Validation("invalid_quantity")
+	}
+
+	if len(idempotencyKey) > maxIdempotencyKeyLength {
+		return 0, errresponse.Validation("idempotency_key_too_long")
+	}
+
 	tx, err := svc.client.DB().BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
 		svc.log.Error().Err(err).Msg("failed to start transaction")
-		return err
+		return 0, err
 	}
 
 	ctx = pg.MakeContextTx(ctx, tx)
 
-	productId, err := svc.appRepository.Shop.UpdateBalanceForPurchase(ctx, userId, productName)
+	replayed, existing, err := svc.claimIdempotencyKey(ctx, models.IdempotencyKey{
+		UserId:    userId,
+		Key:       idempotencyKey,
+		Operation: models.OperationBuyItem,
+		Request:   fmt.Sprintf("%s:%d", productName, quantity),
+	})
+	if err != nil || replayed {
+		_ = tx.Rollback(ctx)
+		return existing.ResourceId, err
+	}
+
+	if _, err := svc.expireUserCoinLots(ctx, userId); err != nil {
+		_ = tx.Rollback(ctx)
+		return 0, err
+	}
+
+	productId, unitPrice, spends, err := svc.appRepository.Shop.UpdateBalanceForPurchase(ctx, userId, productName,
+		quantity)
 	if err != nil {
 		_ = tx.Rollback(ctx)
-		return err
+		return 0, err
 	}
 
-	if err := svc.appRepository.Shop.InsertPurchaseRecord(ctx, userId, *productId); err != nil {
+	purchaseId, err := svc.appRepository.Shop.InsertPurchaseRecord(ctx, userId, productId, quantity, unitPrice)
+	if err != nil {
 		_ = tx.Rollback(ctx)
-		return err
+		return 0, err
 	}
 
-	return tx.Commit(ctx)
+	if err := svc.appRepository.CoinLots.AddPurchaseSpends(ctx, purchaseId, spends); err != nil {
+		_ = tx.Rollback(ctx)
+		return 0, err
+	}
+
+	err = svc.appRepository.Ledger.PostJournal(ctx, purchaseJournal(userId, purchaseId, unitPrice*quantity))
+	if err != nil {
+		_ = tx.Rollback(ctx)
+		return 0, err
+	}
+
+	if err := svc.saveIdempotencyResource(ctx, userId, idempotencyKey, purchaseId); err != nil {
+		_ = tx.Rollback(ctx)
+		return 0, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+
+	return purchaseId, nil
 }
 
 // SendCoins выполняет перевод монет между пользователями.
-// 1. Проверяем корректность суммы и что отправитель и получатель не совпадают.
-// 2. Начинаем транзакцию в БД.
-// 3. Проверяем баланс отправителя.
-// 4. Обновляем баланс отправителя и получателя.
-// 5. Добавлям запись о транзакции в базу данных.
-// 6. Фиксируем транзакцию или откатывает при ошибке.
-func (svc *ShopService) SendCoins(ctx context.Context, sender string, receiver string, amount int) error {
+// 1. Проверяем корректность суммы и сообщения и что отправитель и получатель не совпадают.
+// 2. Начинаем транзакцию в БД и блокируем строки отправителя и получателя.
+// 3. Сохраняем ключ идемпотентности, если он передан. Если перевод
+// с этим ключом уже выполнен, повторно его не выполняем.
+// 4. Проверяем лимиты переводов и баланс отправителя.
+// 5. Обновляем баланс отправителя и получателя.
+// 6. Добавлям запись о транзакции (с сообщением) в базу данных.
+// 7. Записываем проводки перевода в журнал.
+// 8. Фиксируем транзакцию или откатывает при ошибке.
+// При ошибке сериализации или взаимной блокировке транзакция повторяется.
+// Возвращает идентификатор перевода, в том числе для повторного запроса.
+func (svc *ShopService) SendCoins(ctx context.Context, sender string, receiver string, amount int, message string,
+	idempotencyKey string) (int, error) {
+	return svc.transfer(ctx, sender, receiver, amount, message, idempotencyKey, false)
+}
+
+// SendCoinsPending выполняет перевод, который получатель должен подтвердить.
+// Монеты списываются с отправителя и удерживаются, пока получатель не примет
+// или не отклонит перевод. Если перевод отклонён или срок подтверждения истёк,
+// монеты возвращаются отправителю.
+func (svc *ShopService) SendCoinsPending(ctx context.Context, sender string, receiver string, amount int,
+	message string, idempotencyKey string) (int, error) {
+	return svc.transfer(ctx, sender, receiver, amount, message, idempotencyKey, true)
+}
+
+// transfer проверяет параметры перевода и выполняет его с повтором
+// при конкурентных изменениях.
+func (svc *ShopService) transfer(ctx context.Context, sender string, receiver string, amount int, message string,
+	idempotencyKey string, pending bool) (int, error) {
 	if amount <= 0 {
-		return errors.New("сумма перевода должна быть положительным числом")
+		return 0, errresponse.Validation("invalid_transfer_amount")
+	}
+
+	message = strings.TrimSpace(message)
+	if utf8.RuneCountInString(message) > maxTransferMessageLength {
+		return 0, errresponse.Validation("transfer_message_too_long")
 	}
 
 	if sender == receiver {
-		return errors.New("имя отправителя совпадает с именем получателя")
+		return 0, errresponse.Validation("self_transfer")
 	}
 
+	if len(idempotencyKey) > maxIdempotencyKeyLength {
+		return 0, errresponse.Validation("idempotency_key_too_long")
+	}
+
+	var transactionId int
+
+	err := svc.retryTx(ctx, func() error {
+		var err error
+
+		transactionId, err = svc.sendCoins(ctx, sender, receiver, amount, message, idempotencyKey, pending)
+
+		return err
+	})
+
+	return transactionId, err
+}
+
+// sendCoins выполняет перевод в одной транзакции.
+// Строки отправителя и получателя блокируются в порядке возрастания id,
+// поэтому баланс отправителя проверяется без гонок,
+// а встречные переводы не блокируют друг друга.
+// Перевод с подтверждением зачисляется не получателю, а на удержание.
+func (svc *ShopService) sendCoins(ctx context.Context, sender string, receiver string, amount int, message string,
+	idempotencyKey string, pending bool) (int, error) {
 	tx, err := svc.client.DB().BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
 		svc.log.Error().Err(err).Msg("failed to start transaction")
-		return err
+		return 0, err
 	}
 
 	ctx = pg.MakeContextTx(ctx, tx)
 
-	_, senderBalance, err := svc.appRepository.Shop.UserBalanceByName(ctx, sender)
+	transactionId, err := svc.sendCoinsTx(ctx, sender, receiver, amount, message, idempotencyKey, pending)
 	if err != nil {
 		_ = tx.Rollback(ctx)
-		return err
+		return 0, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+
+	return transactionId, nil
+}
+
+// sendCoinsTx выполняет перевод в транзакции, уже открытой в ctx,
+// и возвращает идентификатор перевода. Для повторного запроса
+// с тем же ключом идемпотентности возвращает идентификатор первого перевода.
+func (svc *ShopService) sendCoinsTx(ctx context.Context, sender string, receiver string, amount int,
+	message string, idempotencyKey string, pending bool) (int, error) {
+	users, err := svc.appRepository.Shop.LockUsersByName(ctx, sender, receiver)
+	if err != nil {
+		return 0, err
+	}
+
+	var senderId, senderBalance, receiverId int
+
+	senderFound, receiverFound := false, false
+
+	for _, user := range users {
+		switch user.Username {
+		case sender:
+			senderId, senderBalance, senderFound = user.Id, user.Coins, true
+		case receiver:
+			receiverId, receiverFound = user.Id, true
+		}
+	}
+
+	if !senderFound || !receiverFound {
+		missing := receiver
+		if !senderFound {
+			missing = sender
+		}
+
+		return 0, errresponse.ErrResponse(pgx.ErrNoRows, missing)
+	}
+
+	request := fmt.Sprintf("%s:%d:%s", receiver, amount, message)
+	if pending {
+		request += ":" + models.TransferStatusPending
+	}
+
+	replayed, existing, err := svc.claimIdempotencyKey(ctx, models.IdempotencyKey{
+		UserId:    senderId,
+		Key:       idempotencyKey,
+		Operation: models.OperationSendCoins,
+		Request:   request,
+	})
+	if err != nil || replayed {
+		return existing.ResourceId, err
+	}
+
+	if err := svc.checkTransferLimits(ctx, senderId, amount); err != nil {
+		return 0, err
 	}
 
-	if senderBalance < amount {
+	expired, err := svc.expireUserCoinLots(ctx, senderId)
+	if err != nil {
+		return 0, err
+	}
+
+	if senderBalance-expired < amount {
 		svc.log.Error().Err(err).Msg("not enough coins for transaction")
 
-		_ = tx.Rollback(ctx)
+		return 0, errresponse.InsufficientFunds("insufficient_coins")
+	}
+
+	_, spends, err := svc.appRepository.Shop.UpdateSenderBalance(ctx, sender, amount)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		transactionId int
+		journal       models.Journal
+	)
+
+	if pending {
+		transactionId, err = svc.appRepository.Shop.AddPendingTransaction(ctx, senderId, receiverId, amount,
+			message, svc.acceptWindow)
+		if err != nil {
+			return 0, err
+		}
 
-		return errors.New("недостаточно монет для перевода")
+		journal = transferHoldJournal(senderId, transactionId, amount)
+	} else {
+		if _, err = svc.appRepository.Shop.UpdateReceiverBalance(ctx, receiver, amount, spends); err != nil {
+			return 0, err
+		}
+
+		transactionId, err = svc.appRepository.Shop.AddTransaction(ctx, senderId, receiverId, amount, message)
+		if err != nil {
+			return 0, err
+		}
+
+		journal = transferJournal(senderId, receiverId, transactionId, amount)
+	}
+
+	if err = svc.appRepository.CoinLots.AddTransferSpends(ctx, transactionId, spends); err != nil {
+		return 0, err
 	}
 
-	senderId, err := svc.appRepository.Shop.UpdateSenderBalance(ctx, sender, amount)
+	if err = svc.appRepository.Ledger.PostJournal(ctx, journal); err != nil {
+		return 0, err
+	}
+
+	if err = svc.saveIdempotencyResource(ctx, senderId, idempotencyKey, transactionId); err != nil {
+		return 0, err
+	}
+
+	return transactionId, nil
+}
+
+// AcceptTransfer принимает перевод, ожидающий подтверждения.
+// 1. Начинаем транзакцию в БД и блокируем перевод.
+// 2. Проверяем, что перевод адресован пользователю, ещё ожидает
+// подтверждения и срок подтверждения не истёк.
+// 3. Зачисляем удержанные монеты получателю и помечаем перевод выполненным.
+// 4. Записываем проводки в журнал.
+// 5. Фиксируем транзакцию или откатываем при ошибке.
+func (svc *ShopService) AcceptTransfer(ctx context.Context, userId int, transactionId int) error {
+	return svc.retryTx(ctx, func() error {
+		return svc.resolveTransfer(ctx, userId, transactionId, models.TransferStatusCompleted)
+	})
+}
+
+// DeclineTransfer отклоняет перевод, ожидающий подтверждения,
+// и возвращает удержанные монеты отправителю.
+func (svc *ShopService) DeclineTransfer(ctx context.Context, userId int, transactionId int) error {
+	return svc.retryTx(ctx, func() error {
+		return svc.resolveTransfer(ctx, userId, transactionId, models.TransferStatusDeclined)
+	})
+}
+
+// resolveTransfer принимает или отклоняет перевод по решению получателя.
+func (svc *ShopService) resolveTransfer(ctx context.Context, userId int, transactionId int, status string) error {
+	tx, err := svc.client.DB().BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
-		_ = tx.Rollback(ctx)
+		svc.log.Error().Err(err).Msg("failed to start transaction")
 		return err
 	}
 
-	receiverId, err := svc.appRepository.Shop.UpdateReceiverBalance(ctx, receiver, amount)
+	ctx = pg.MakeContextTx(ctx, tx)
+
+	transfer, err := svc.appRepository.Shop.GetPendingTransfer(ctx, transactionId)
 	if err != nil {
 		_ = tx.Rollback(ctx)
 		return err
 	}
 
-	if err = svc.appRepository.Shop.AddTransaction(ctx, senderId, receiverId, amount); err != nil {
+	if transfer.ReceiverId != userId {
+		_ = tx.Rollback(ctx)
+		return errresponse.ErrResponse(pgx.ErrNoRows, i18n.EntityTransfer)
+	}
+
+	if transfer.Status != models.TransferStatusPending {
+		_ = tx.Rollback(ctx)
+		return errresponse.Conflict("transfer_already_resolved")
+	}
+
+	if status == models.TransferStatusCompleted && transfer.Expired {
+		_ = tx.Rollback(ctx)
+		return errresponse.Conflict("transfer_accept_expired")
+	}
+
+	if err := svc.settleTransfer(ctx, transfer, status); err != nil {
 		_ = tx.Rollback(ctx)
 		return err
 	}
@@ -128,6 +443,306 @@ func (svc *ShopService) SendCoins(ctx context.Context, sender string, receiver s
 	return tx.Commit(ctx)
 }
 
+// ExpirePendingTransfers возвращает отправителям монеты по переводам,
+// срок подтверждения которых истёк. Каждый перевод обрабатывается
+// в отдельной транзакции, ошибка одного перевода не мешает обработать
+// остальные. Возвращает количество обработанных переводов и ошибки
+// необработанных.
+func (svc *ShopService) ExpirePendingTransfers(ctx context.Context) (int, error) {
+	ids, err := svc.appRepository.Shop.ExpiredPendingTransferIds(ctx, expirePendingTransfersBatch)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		expired int
+		errs    []error
+	)
+
+	for _, id := range ids {
+		var done bool
+
+		err := svc.retryTx(ctx, func() error {
+			var err error
+
+			done, err = svc.expireTransfer(ctx, id)
+
+			return err
+		})
+		if err != nil {
+			svc.log.Error().Err(err).Int("transactionId", id).Msg("failed to expire pending transfer")
+			errs = append(errs, fmt.Errorf("transaction %d: %w", id, err))
+
+			continue
+		}
+
+		if done {
+			expired++
+		}
+	}
+
+	return expired, errors.Join(errs...)
+}
+
+// expireTransfer возвращает монеты по просроченному переводу.
+// Перевод мог быть обработан получателем после выборки, поэтому его
+// статус и срок проверяются повторно под блокировкой.
+func (svc *ShopService) expireTransfer(ctx context.Context, transactionId int) (bool, error) {
+	tx, err := svc.client.DB().BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		svc.log.Error().Err(err).Msg("failed to start transaction")
+		return false, err
+	}
+
+	ctx = pg.MakeContextTx(ctx, tx)
+
+	transfer, err := svc.appRepository.Shop.GetPendingTransfer(ctx, transactionId)
+	if err != nil {
+		_ = tx.Rollback(ctx)
+		return false, err
+	}
+
+	if transfer.Status != models.TransferStatusPending || !transfer.Expired {
+		_ = tx.Rollback(ctx)
+		return false, nil
+	}
+
+	if err := svc.settleTransfer(ctx, transfer, models.TransferStatusExpired); err != nil {
+		_ = tx.Rollback(ctx)
+		return false, err
+	}
+
+	return true, tx.Commit(ctx)
+}
+
+// settleTransfer зачисляет удержанные монеты получателю, если перевод принят,
+// или возвращает их отправителю, и записывает проводки в журнал. В обоих
+// случаях монеты сохраняют срок действия партий отправителя.
+// Вызывается внутри транзакции, в которой перевод заблокирован.
+func (svc *ShopService) settleTransfer(ctx context.Context, transfer models.PendingTransfer, status string) error {
+	userId, journal := transfer.SenderId, transferReleaseJournal(transfer.SenderId, transfer.Id, transfer.Amount)
+	if status == models.TransferStatusCompleted {
+		userId, journal = transfer.ReceiverId, transferAcceptJournal(transfer.ReceiverId, transfer.Id, transfer.Amount)
+	}
+
+	if err := svc.appRepository.Shop.ResolvePendingTransfer(ctx, transfer.Id, status); err != nil {
+		return err
+	}
+
+	lots, err := svc.appRepository.CoinLots.TransferSpends(ctx, transfer.Id)
+	if err != nil {
+		return err
+	}
+
+	if err := svc.appRepository.Shop.CreditBalance(ctx, userId, transfer.Amount, lots); err != nil {
+		return err
+	}
+
+	return svc.appRepository.Ledger.PostJournal(ctx, journal)
+}
+
+// SendCoinsBatch переводит монеты нескольким получателям одной операцией.
+// 1. Проверяем список получателей, суммы и сообщение.
+// 2. Начинаем транзакцию в БД и блокируем строки отправителя и всех получателей.
+// 3. Сохраняем ключ идемпотентности, если он передан. Если перевод
+// с этим ключом уже выполнен, повторно его не выполняем и возвращаем
+// созданные им переводы.
+// 4. Проверяем лимиты переводов и что баланса отправителя хватает на всю сумму.
+// Каждый получатель учитывается как отдельный перевод.
+// 5. Списываем общую сумму с отправителя одним обновлением.
+// 6. Зачисляем монеты каждому получателю, добавляем запись о транзакции
+// и проводки перевода в журнал.
+// 7. Запоминаем созданные переводы для ключа идемпотентности и фиксируем
+// транзакцию или откатываем при ошибке: перевод выполняется только целиком.
+// Возвращает идентификаторы переводов в порядке получателей.
+// При ошибке сериализации или взаимной блокировке транзакция повторяется.
+func (svc *ShopService) SendCoinsBatch(ctx context.Context, sender string, recipients []models.TransferRecipient,
+	message string, idempotencyKey string) ([]int, error) {
+	if len(recipients) == 0 {
+		return nil, errresponse.Validation("no_recipients")
+	}
+
+	if len(recipients) > maxBatchRecipients {
+		return nil, errresponse.Validation("too_many_recipients", maxBatchRecipients)
+	}
+
+	seen := make(map[string]struct{}, len(recipients))
+
+	for _, recipient := range recipients {
+		if recipient.Amount <= 0 {
+			return nil, errresponse.Validation("invalid_transfer_amount")
+		}
+
+		if recipient.ToUser == sender {
+			return nil, errresponse.Validation("self_transfer")
+		}
+
+		if _, ok := seen[recipient.ToUser]; ok {
+			return nil, errresponse.Validation("duplicate_recipient", recipient.ToUser)
+		}
+
+		seen[recipient.ToUser] = struct{}{}
+	}
+
+	message = strings.TrimSpace(message)
+	if utf8.RuneCountInString(message) > maxTransferMessageLength {
+		return nil, errresponse.Validation("transfer_message_too_long")
+	}
+
+	if len(idempotencyKey) > maxIdempotencyKeyLength {
+		return nil, errresponse.Validation("idempotency_key_too_long")
+	}
+
+	var transactionIds []int
+
+	err := svc.retryTx(ctx, func() error {
+		var err error
+
+		transactionIds, err = svc.sendCoinsBatch(ctx, sender, recipients, message, idempotencyKey)
+
+		return err
+	})
+
+	return transactionIds, err
+}
+
+// sendCoinsBatch выполняет пакетный перевод в одной транзакции.
+// Строки блокируются так же, как в sendCoins, в порядке возрастания id.
+func (svc *ShopService) sendCoinsBatch(ctx context.Context, sender string, recipients []models.TransferRecipient,
+	message string, idempotencyKey string) ([]int, error) {
+	tx, err := svc.client.DB().BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		svc.log.Error().Err(err).Msg("failed to start transaction")
+		return nil, err
+	}
+
+	ctx = pg.MakeContextTx(ctx, tx)
+
+	usernames := make([]string, 0, len(recipients)+1)
+	usernames = append(usernames, sender)
+
+	request := make([]string, 0, len(recipients)+1)
+
+	total := 0
+
+	for _, recipient := range recipients {
+		usernames = append(usernames, recipient.ToUser)
+		request = append(request, fmt.Sprintf("%s:%d", recipient.ToUser, recipient.Amount))
+		total += recipient.Amount
+	}
+
+	request = append(request, message)
+
+	users, err := svc.appRepository.Shop.LockUsersByName(ctx, usernames...)
+	if err != nil {
+		_ = tx.Rollback(ctx)
+		return nil, err
+	}
+
+	found := make(map[string]models.User, len(users))
+	for _, user := range users {
+		found[user.Username] = user
+	}
+
+	for _, username := range usernames {
+		if _, ok := found[username]; !ok {
+			_ = tx.Rollback(ctx)
+			return nil, errresponse.ErrResponse(pgx.ErrNoRows, username)
+		}
+	}
+
+	senderId := found[sender].Id
+
+	replayed, existing, err := svc.claimIdempotencyKey(ctx, models.IdempotencyKey{
+		UserId:    senderId,
+		Key:       idempotencyKey,
+		Operation: models.OperationSendCoinsBatch,
+		Request:   strings.Join(request, ";"),
+	})
+	if err != nil || replayed {
+		_ = tx.Rollback(ctx)
+		return existing.ResourceIds, err
+	}
+
+	amounts := make([]int, 0, len(recipients))
+	for _, recipient := range recipients {
+		amounts = append(amounts, recipient.Amount)
+	}
+
+	if err := svc.checkTransferLimits(ctx, senderId, amounts...); err != nil {
+		_ = tx.Rollback(ctx)
+		return nil, err
+	}
+
+	expired, err := svc.expireUserCoinLots(ctx, senderId)
+	if err != nil {
+		_ = tx.Rollback(ctx)
+		return nil, err
+	}
+
+	if found[sender].Coins-expired < total {
+		svc.log.Error().Msg("not enough coins for batch transaction")
+
+		_ = tx.Rollback(ctx)
+
+		return nil, errresponse.InsufficientFunds("insufficient_coins")
+	}
+
+	_, spends, err := svc.appRepository.Shop.UpdateSenderBalance(ctx, sender, total)
+	if err != nil {
+		_ = tx.Rollback(ctx)
+		return nil, err
+	}
+
+	transactionIds := make([]int, 0, len(recipients))
+
+	for _, recipient := range recipients {
+		var lots []models.CoinLotSpend
+
+		lots, spends = splitCoinLotSpends(spends, recipient.Amount)
+
+		receiverId, err := svc.appRepository.Shop.UpdateReceiverBalance(ctx, recipient.ToUser, recipient.Amount,
+			lots)
+		if err != nil {
+			_ = tx.Rollback(ctx)
+			return nil, err
+		}
+
+		transactionId, err := svc.appRepository.Shop.AddTransaction(ctx, senderId, receiverId, recipient.Amount,
+			message)
+		if err != nil {
+			_ = tx.Rollback(ctx)
+			return nil, err
+		}
+
+		if err := svc.appRepository.CoinLots.AddTransferSpends(ctx, transactionId, lots); err != nil {
+			_ = tx.Rollback(ctx)
+			return nil, err
+		}
+
+		err = svc.appRepository.Ledger.PostJournal(ctx,
+			transferJournal(senderId, receiverId, transactionId, recipient.Amount))
+		if err != nil {
+			_ = tx.Rollback(ctx)
+			return nil, err
+		}
+
+		transactionIds = append(transactionIds, transactionId)
+	}
+
+	err = svc.saveIdempotencyResources(ctx, senderId, idempotencyKey, transactionIds)
+	if err != nil {
+		_ = tx.Rollback(ctx)
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return transactionIds, nil
+}
+
 // Info предоставляет информацию о пользователе.
 // 1. Получаем баланс пользователя.
 // 2. Извлекаем список его покупок.
@@ -162,3 +777,426 @@ func (svc *ShopService) Info(ctx context.Context, username string) (
 
 	return coins, items, sentCoins, receivedCoins, nil
 }
+
+// SentTransfer возвращает перевод, отправленный пользователем. Встречные
+// переводы, которыми администратор возвращает монеты, не возвращаются.
+func (svc *ShopService) SentTransfer(ctx context.Context, userId int, transactionId int) (models.Transaction, error) {
+	transfer, err := svc.appRepository.Shop.GetTransactionByUserId(ctx, userId, transactionId)
+	if err != nil {
+		return models.Transaction{}, err
+	}
+
+	if transfer.Direction != models.TransactionDirectionSent || transfer.Status == models.TransferStatusReversal {
+		return models.Transaction{}, errresponse.ErrResponse(pgx.ErrNoRows, i18n.EntityTransfer)
+	}
+
+	return transfer, nil
+}
+
+// Transactions возвращает страницу истории переводов пользователя, начиная с последних.
+// 1. Проверяем размер страницы, период и статус, разбираем курсор.
+// 2. Запрашиваем на один перевод больше размера страницы,
+// чтобы понять, есть ли следующая страница.
+// 3. Если есть, возвращаем курсор, указывающий на последний перевод страницы.
+func (svc *ShopService) Transactions(ctx context.Context, userId int, cursor string,
+	filter models.TransactionsFilter) (
+	transactions []models.Transaction,
+	nextCursor string,
+	err error,
+) {
+	switch {
+	case filter.Limit == 0:
+		filter.Limit = defaultTransactionsLimit
+	case filter.Limit < 0 || filter.Limit > maxTransactionsLimit:
+		return nil, "", errresponse.Validation("invalid_page_size")
+	}
+
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return nil, "", errresponse.Validation("invalid_period")
+	}
+
+	switch filter.Status {
+	case "", models.TransferStatusCompleted, models.TransferStatusPending,
+		models.TransferStatusDeclined, models.TransferStatusExpired,
+		models.TransferStatusReversed, models.TransferStatusReversal:
+	default:
+		return nil, "", errresponse.Validation("invalid_transfer_status")
+	}
+
+	if filter.From != nil {
+		from := filter.From.UTC()
+		filter.From = &from
+	}
+
+	if filter.To != nil {
+		to := filter.To.UTC()
+		filter.To = &to
+	}
+
+	if cursor != "" {
+		filter.BeforeId, err = decodeCursor(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	pageSize := filter.Limit
+	filter.Limit++
+
+	transactions, err = svc.appRepository.Shop.GetTransactionsByUserId(ctx, userId, filter)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(transactions) > pageSize {
+		transactions = transactions[:pageSize]
+		nextCursor = encodeCursor(transactions[pageSize-1].Id)
+	}
+
+	return transactions, nextCursor, nil
+}
+
+// AddToCart добавляет товар в корзину пользователя.
+// Если товар уже лежит в корзине, его количество увеличивается.
+func (svc *ShopService) AddToCart(ctx context.Context, userId int, productName string, quantity int) error {
+	if quantity <= 0 {
+		return errresponse.Validation("invalid_quantity")
+	}
+
+	return svc.appRepository.Cart.AddCartItem(ctx, userId, productName, quantity)
+}
+
+// RemoveFromCart удаляет товар из корзины пользователя.
+func (svc *ShopService) RemoveFromCart(ctx context.Context, userId int, productName string) error {
+	return svc.appRepository.Cart.RemoveCartItem(ctx, userId, productName)
+}
+
+// Cart возвращает содержимое корзины пользователя и её итоговую стоимость.
+func (svc *ShopService) Cart(ctx context.Context, userId int) (items []models.CartItem, total int, err error) {
+	items, err = svc.appRepository.Cart.GetCartItems(ctx, userId)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for _, item := range items {
+		total += item.Price * item.Quantity
+	}
+
+	return items, total, nil
+}
+
+// Checkout оформляет покупку всех товаров из корзины.
+// 1. Начинаем транзакцию в БД.
+// 2. Получаем и блокируем содержимое корзины, пустую корзину оформить нельзя.
+// 3. Для каждой позиции списываем монеты, записываем покупку и её проводки.
+// 4. Удаляем из корзины оформленные позиции.
+// 5. Фиксируем транзакцию или откатываем при ошибке,
+// так что покупаются либо все товары, либо ни одного.
+// При конфликте с параллельной транзакцией оформление повторяется.
+func (svc *ShopService) Checkout(ctx context.Context, userId int) error {
+	return svc.retryTx(ctx, func() error {
+		return svc.checkout(ctx, userId)
+	})
+}
+
+func (svc *ShopService) checkout(ctx context.Context, userId int) error {
+	tx, err := svc.client.DB().BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		svc.log.Error().Err(err).Msg("failed to start transaction")
+		return err
+	}
+
+	ctx = pg.MakeContextTx(ctx, tx)
+
+	items, err := svc.appRepository.Cart.LockCartItems(ctx, userId)
+	if err != nil {
+		_ = tx.Rollback(ctx)
+		return err
+	}
+
+	if len(items) == 0 {
+		_ = tx.Rollback(ctx)
+		return errresponse.Validation("cart_empty")
+	}
+
+	if _, err := svc.expireUserCoinLots(ctx, userId); err != nil {
+		_ = tx.Rollback(ctx)
+		return err
+	}
+
+	productIds := make([]int, 0, len(items))
+
+	for _, item := range items {
+		productIds = append(productIds, item.ProductId)
+
+		productId, unitPrice, spends, err := svc.appRepository.Shop.UpdateBalanceForPurchase(ctx, userId, item.Name,
+			item.Quantity)
+		if err != nil {
+			_ = tx.Rollback(ctx)
+			return err
+		}
+
+		purchaseId, err := svc.appRepository.Shop.InsertPurchaseRecord(ctx, userId, productId, item.Quantity, unitPrice)
+		if err != nil {
+			_ = tx.Rollback(ctx)
+			return err
+		}
+
+		if err := svc.appRepository.CoinLots.AddPurchaseSpends(ctx, purchaseId, spends); err != nil {
+			_ = tx.Rollback(ctx)
+			return err
+		}
+
+		err = svc.appRepository.Ledger.PostJournal(ctx, purchaseJournal(userId, purchaseId, unitPrice*item.Quantity))
+		if err != nil {
+			_ = tx.Rollback(ctx)
+			return err
+		}
+	}
+
+	if err := svc.appRepository.Cart.RemoveCartItems(ctx, userId, productIds); err != nil {
+		_ = tx.Rollback(ctx)
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// RefundPurchase оформляет возврат покупки.
+// 1. Начинаем транзакцию в БД.
+// 2. Блокируем запись о покупке и проверяем, что она ещё не возвращена
+// и срок возврата не истёк.
+// 3. Помечаем покупку как возвращённую, запись при этом сохраняется.
+// 4. Возвращаем пользователю уплаченную сумму со сроком действия партий,
+// из которых она была списана, и товар на склад.
+// 5. Записываем проводки возврата в журнал.
+// 6. Фиксируем транзакцию или откатываем при ошибке.
+func (svc *ShopService) RefundPurchase(ctx context.Context, userId int, purchaseId int) error {
+	tx, err := svc.client.DB().BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		svc.log.Error().Err(err).Msg("failed to start transaction")
+		return err
+	}
+
+	ctx = pg.MakeContextTx(ctx, tx)
+
+	purchase, err := svc.appRepository.Shop.GetPurchaseForRefund(ctx, userId, purchaseId, svc.refundWindow)
+	if err != nil {
+		_ = tx.Rollback(ctx)
+		return err
+	}
+
+	if purchase.Status == models.PurchaseStatusRefunded {
+		_ = tx.Rollback(ctx)
+		return errresponse.Conflict("purchase_already_refunded")
+	}
+
+	if !purchase.WithinRefundWindow {
+		_ = tx.Rollback(ctx)
+		return errresponse.Conflict("refund_window_expired")
+	}
+
+	if err := svc.appRepository.Shop.MarkPurchaseRefunded(ctx, purchase.Id); err != nil {
+		_ = tx.Rollback(ctx)
+		return err
+	}
+
+	lots, err := svc.appRepository.CoinLots.PurchaseSpends(ctx, purchase.Id)
+	if err != nil {
+		_ = tx.Rollback(ctx)
+		return err
+	}
+
+	err = svc.appRepository.Shop.RestoreBalanceForRefund(ctx, userId, purchase.ProductId, purchase.Quantity,
+		purchase.TotalPrice, lots)
+	if err != nil {
+		_ = tx.Rollback(ctx)
+		return err
+	}
+
+	err = svc.appRepository.Ledger.PostJournal(ctx, refundJournal(userId, purchase.Id, purchase.TotalPrice))
+	if err != nil {
+		_ = tx.Rollback(ctx)
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// Purchase возвращает покупку пользователя.
+func (svc *ShopService) Purchase(ctx context.Context, userId int, purchaseId int) (models.PurchaseRecord, error) {
+	return svc.appRepository.Shop.GetPurchaseByUserId(ctx, userId, purchaseId)
+}
+
+// Purchases возвращает страницу истории покупок пользователя, начиная с последних.
+// Вместе со страницей возвращается общее количество покупок и сумма,
+// потраченная на невозвращённые покупки.
+func (svc *ShopService) Purchases(ctx context.Context, userId int, filter models.PurchasesFilter) (
+	purchases []models.PurchaseRecord,
+	total int,
+	spent int,
+	err error,
+) {
+	switch {
+	case filter.Limit == 0:
+		filter.Limit = defaultPurchasesLimit
+	case filter.Limit < 0 || filter.Limit > maxPurchasesLimit:
+		return nil, 0, 0, errresponse.Validation("invalid_page_size")
+	}
+
+	if filter.Offset < 0 {
+		return nil, 0, 0, errresponse.Validation("negative_offset")
+	}
+
+	purchases, err = svc.appRepository.Shop.GetPurchasesByUserId(ctx, userId, filter)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	total, spent, err = svc.appRepository.Shop.PurchasesSummaryByUserId(ctx, userId)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	return purchases, total, spent, nil
+}
+
+// Products возвращает страницу каталога товаров.
+// 1. Проверяем параметры сортировки и пагинации, подставляем значения по умолчанию.
+// 2. Получаем товары и их общее количество.
+func (svc *ShopService) Products(ctx context.Context, filter models.ProductsFilter) (
+	products []models.Product,
+	total int,
+	err error,
+) {
+	switch filter.SortBy {
+	case "":
+		filter.SortBy = "name"
+	case "name", "price":
+	default:
+		return nil, 0, errresponse.Validation("invalid_sort_field")
+	}
+
+	switch filter.Order {
+	case "":
+		filter.Order = "asc"
+	case "asc", "desc":
+	default:
+		return nil, 0, errresponse.Validation("invalid_sort_order")
+	}
+
+	switch {
+	case filter.Limit == 0:
+		filter.Limit = defaultProductsLimit
+	case filter.Limit < 0 || filter.Limit > maxProductsLimit:
+		return nil, 0, errresponse.Validation("invalid_page_size")
+	}
+
+	if filter.Offset < 0 {
+		return nil, 0, errresponse.Validation("negative_offset")
+	}
+
+	products, err = svc.appRepository.Shop.GetProducts(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err = svc.appRepository.Shop.CountProducts(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return products, total, nil
+}
+
+// claimIdempotencyKey сохраняет ключ идемпотентности в текущей транзакции.
+// Возвращает true и сохранённый ключ с созданными ресурсами, если операция
+// с этим ключом уже была выполнена, и ошибку, если ключ использовался для
+// другого запроса. Пустой ключ не сохраняется.
+func (svc *ShopService) claimIdempotencyKey(ctx context.Context, key models.IdempotencyKey) (
+	replayed bool, existing models.IdempotencyKey, err error) {
+	if key.Key == "" {
+		return false, existing, nil
+	}
+
+	saved, err := svc.appRepository.Idempotency.SaveIdempotencyKey(ctx, key)
+	if err != nil || saved {
+		return false, existing, err
+	}
+
+	existing, err = svc.appRepository.Idempotency.GetIdempotencyKey(ctx, key.UserId, key.Key)
+	if err != nil {
+		return false, models.IdempotencyKey{}, err
+	}
+
+	if existing.Operation != key.Operation || existing.Request != key.Request {
+		return false, models.IdempotencyKey{}, errresponse.Conflict("idempotency_key_reused")
+	}
+
+	svc.log.Info().Int("userId", key.UserId).Str("key", key.Key).Msg("idempotent request replayed")
+
+	return true, existing, nil
+}
+
+// saveIdempotencyResource запоминает ресурс, созданный запросом с ключом
+// идемпотентности, чтобы вернуть его на повторный запрос.
+func (svc *ShopService) saveIdempotencyResource(ctx context.Context, userId int, key string, resourceId int) error {
+	if key == "" {
+		return nil
+	}
+
+	return svc.appRepository.Idempotency.SetIdempotencyKeyResource(ctx, userId, key, resourceId)
+}
+
+// saveIdempotencyResources запоминает переводы, созданные пакетным запросом
+// с ключом идемпотентности.
+func (svc *ShopService) saveIdempotencyResources(ctx context.Context, userId int, key string,
+	resourceIds []int) error {
+	if key == "" {
+		return nil
+	}
+
+	return svc.appRepository.Idempotency.SetIdempotencyKeyResources(ctx, userId, key, resourceIds)
+}
+
+// checkTransferLimits проверяет, что переводы amounts не превышают лимиты отправителя.
+// Вызывается внутри транзакции после блокировки строки отправителя,
+// поэтому параллельные переводы не могут обойти лимит.
+func (svc *ShopService) checkTransferLimits(ctx context.Context, senderId int, amounts ...int) error {
+	override, err := svc.appRepository.TransferLimits.GetTransferLimits(ctx, senderId)
+	if err != nil {
+		return err
+	}
+
+	limits := effectiveTransferLimits(svc.limits, override)
+
+	total := 0
+
+	for _, amount := range amounts {
+		if limits.MaxAmount > 0 && amount > limits.MaxAmount {
+			return &errresponse.TransferLimitError{Code: errresponse.TransferLimitMaxAmount, Limit: limits.MaxAmount}
+		}
+
+		total += amount
+	}
+
+	if limits.DailyLimit == 0 && limits.WeeklyLimit == 0 && limits.HourlyCount == 0 {
+		return nil
+	}
+
+	stats, err := svc.appRepository.TransferLimits.GetTransferStats(ctx, senderId)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case limits.HourlyCount > 0 && stats.HourlyCount+len(amounts) > limits.HourlyCount:
+		return &errresponse.TransferLimitError{Code: errresponse.TransferLimitHourlyCount, Limit: limits.HourlyCount}
+	case limits.DailyLimit > 0 && stats.Daily+total > limits.DailyLimit:
+		return &errresponse.TransferLimitError{Code: errresponse.TransferLimitDaily, Limit: limits.DailyLimit}
+	case limits.WeeklyLimit > 0 && stats.Weekly+total > limits.WeeklyLimit:
+		return &errresponse.TransferLimitError{Code: errresponse.TransferLimitWeekly, Limit: limits.WeeklyLimit}
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+
+	errresponse "github.com/MaksimovDenis/Avito_merch_shop/internal/err_response"
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/models"
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/repository"
+	"github.com/rs/zerolog"
+)
+
+type TransferLimits interface {
+	TransferLimits(ctx context.Context, username string) (models.TransferLimits, error)
+	SetTransferLimits(ctx context.Context, username string, override models.TransferLimitsOverride) (
+		models.TransferLimits, error)
+}
+
+type TransferLimitsService struct {
+	appRepository repository.Repository
+	defaults      models.TransferLimits
+	log           zerolog.Logger
+}
+
+func newTransferLimitsService(
+	appRepository repository.Repository,
+	defaults models.TransferLimits,
+	log zerolog.Logger,
+) *TransferLimitsService {
+	return &TransferLimitsService{
+		appRepository: appRepository,
+		defaults:      defaults,
+		log:           log,
+	}
+}
+
+// TransferLimits возвращает действующие лимиты переводов пользователя.
+func (svc *TransferLimitsService) TransferLimits(ctx context.Context, username string) (
+	models.TransferLimits, error) {
+	userId, _, err := svc.appRepository.Shop.UserBalanceByName(ctx, username)
+	if err != nil {
+		return models.TransferLimits{}, err
+	}
+
+	override, err := svc.appRepository.TransferLimits.GetTransferLimits(ctx, userId)
+	if err != nil {
+		return models.TransferLimits{}, err
+	}
+
+	return effectiveTransferLimits(svc.defaults, override), nil
+}
+
+// SetTransferLimits задаёт пользователю лимиты переводов.
+// 1. Проверяем, что лимиты не отрицательные.
+// 2. Заменяем лимиты пользователя: незаданные лимиты берутся из конфигурации.
+// 3. Возвращаем действующие лимиты.
+func (svc *TransferLimitsService) SetTransferLimits(ctx context.Context, username string,
+	override models.TransferLimitsOverride) (models.TransferLimits, error) {
+	for _, limit := range []*int{override.MaxAmount, override.DailyLimit, override.WeeklyLimit, override.HourlyCount} {
+		if limit != nil && *limit < 0 {
+			return models.TransferLimits{}, errresponse.Validation("negative_transfer_limit")
+		}
+	}
+
+	userId, _, err := svc.appRepository.Shop.UserBalanceByName(ctx, username)
+	if err != nil {
+		return models.TransferLimits{}, err
+	}
+
+	if err := svc.appRepository.TransferLimits.SetTransferLimits(ctx, userId, override); err != nil {
+		return models.TransferLimits{}, err
+	}
+
+	return effectiveTransferLimits(svc.defaults, override), nil
+}
+
+// effectiveTransferLimits дополняет лимиты пользователя значениями по умолчанию.
+func effectiveTransferLimits(defaults models.TransferLimits, override models.TransferLimitsOverride) models.TransferLimits {
+	limits := defaults
+
+	if override.MaxAmount != nil {
+		limits.MaxAmount = *override.MaxAmount
+	}
+
+	if override.DailyLimit != nil {
+		limits.DailyLimit = *override.DailyLimit
+	}
+
+	if override.WeeklyLimit != nil {
+		limits.WeeklyLimit = *override.WeeklyLimit
+	}
+
+	if override.HourlyCount != nil {
+		limits.HourlyCount = *override.HourlyCount
+	}
+
+	return limits
+}
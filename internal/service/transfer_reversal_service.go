@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	db "github.com/MaksimovDenis/Avito_merch_shop/internal/client"
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/client/db/pg"
+	errresponse "github.com/MaksimovDenis/Avito_merch_shop/internal/err_response"
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/models"
+	"github.com/MaksimovDenis/Avito_merch_shop/internal/repository"
+	"github.com/jackc/pgx/v4"
+	"github.com/rs/zerolog"
+)
+
+type TransferReversal interface {
+	ReverseTransfer(ctx context.Context, admin string, transactionId int, reason string, force bool) (
+		models.TransferReversal, error)
+}
+
+const maxReversalReasonLength = 255
+
+type TransferReversalService struct {
+	appRepository repository.Repository
+	client        db.Client
+	shop          *ShopService
+	log           zerolog.Logger
+}
+
+func newTransferReversalService(
+	appRepository repository.Repository,
+	client db.Client,
+	shop *ShopService,
+	log zerolog.Logger,
+) *TransferReversalService {
+	return &TransferReversalService{
+		appRepository: appRepository,
+		client:        client,
+		shop:          shop,
+		log:           log,
+	}
+}
+
+// ReverseTransfer отменяет выполненный перевод по решению администратора.
+// 1. Проверяем причину отмены.
+// 2. Начинаем транзакцию в БД, блокируем перевод и проверяем, что он выполнен
+// и ещё не отменён.
+// 3. Блокируем отправителя и получателя. Если получатель уже потратил монеты,
+// отменяем только при force: баланс получателя становится отрицательным.
+// 4. Возвращаем монеты отправителю со сроком действия его партий, из которых
+// они были списаны, записываем встречный перевод со ссылкой на исходный,
+// проводки и запись аудита. Исходный перевод не изменяется, а оплаченный им
+// запрос монет отмечается отменённым. Фиксируем транзакцию.
+func (svc *TransferReversalService) ReverseTransfer(ctx context.Context, admin string, transactionId int,
+	reason string, force bool) (models.TransferReversal, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return models.TransferReversal{}, errresponse.Validation("reversal_reason_required")
+	}
+
+	if utf8.RuneCountInString(reason) > maxReversalReasonLength {
+		return models.TransferReversal{}, errresponse.Validation("reversal_reason_too_long",
+			maxReversalReasonLength)
+	}
+
+	var reversal models.TransferReversal
+
+	err := svc.shop.retryTx(ctx, func() error {
+		var err error
+
+		reversal, err = svc.reverseTransfer(ctx, admin, transactionId, reason, force)
+
+		return err
+	})
+
+	return reversal, err
+}
+
+func (svc *TransferReversalService) reverseTransfer(ctx context.Context, admin string, transactionId int,
+	reason string, force bool) (models.TransferReversal, error) {
+	tx, err := svc.client.DB().BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		svc.log.Error().Err(err).Msg("failed to start transaction")
+		return models.TransferReversal{}, err
+	}
+
+	ctx = pg.MakeContextTx(ctx, tx)
+
+	transfer, err := svc.appRepository.TransferReversal.GetTransferForReversal(ctx, transactionId)
+	if err != nil {
+		_ = tx.Rollback(ctx)
+		return models.TransferReversal{}, err
+	}
+
+	switch transfer.Status {
+	case models.TransferStatusCompleted:
+	case models.TransferStatusReversed:
+		_ = tx.Rollback(ctx)
+		return models.TransferReversal{}, errresponse.Conflict("transfer_already_reversed")
+	default:
+		_ = tx.Rollback(ctx)
+		return models.TransferReversal{}, errresponse.Conflict("transfer_not_reversible")
+	}
+
+	users, err := svc.appRepository.Shop.LockUsersByName(ctx, transfer.Sender, transfer.Receiver)
+	if err != nil {
+		_ = tx.Rollback(ctx)
+		return models.TransferReversal{}, err
+	}
+
+	expired, err := svc.shop.expireUserCoinLots(ctx, transfer.ReceiverId)
+	if err != nil {
+		_ = tx.Rollback(ctx)
+		return models.TransferReversal{}, err
+	}
+
+	for _, user := range users {
+		if user.Id == transfer.ReceiverId && user.Coins-expired < transfer.Amount && !force {
+			_ = tx.Rollback(ctx)
+			return models.TransferReversal{}, errresponse.Conflict("receiver_spent_coins",
+				user.Coins-expired, transfer.Amount)
+		}
+	}
+
+	err = svc.appRepository.Shop.DebitBalance(ctx, transfer.ReceiverId, transfer.Amount, force)
+	if err != nil {
+		_ = tx.Rollback(ctx)
+		return models.TransferReversal{}, err
+	}
+
+	lots, err := svc.appRepository.CoinLots.TransferSpends(ctx, transfer.Id)
+	if err != nil {
+		_ = tx.Rollback(ctx)
+		return models.TransferReversal{}, err
+	}
+
+	err = svc.appRepository.Shop.CreditBalance(ctx, transfer.SenderId, transfer.Amount, lots)
+	if err != nil {
+		_ = tx.Rollback(ctx)
+		return models.TransferReversal{}, err
+	}
+
+	reversalId, err := svc.appRepository.TransferReversal.AddReversalTransaction(ctx, transfer, reason)
+	if err != nil {
+		_ = tx.Rollback(ctx)
+		return models.TransferReversal{}, err
+	}
+
+	err = svc.appRepository.Ledger.PostJournal(ctx, transferReversalJournal(transfer, reversalId))
+	if err != nil {
+		_ = tx.Rollback(ctx)
+		return models.TransferReversal{}, err
+	}
+
+	err = svc.appRepository.PaymentRequest.MarkPaymentRequestReversed(ctx, transfer.Id)
+	if err != nil {
+		_ = tx.Rollback(ctx)
+		return models.TransferReversal{}, err
+	}
+
+	reversal, err := svc.appRepository.TransferReversal.AddTransferReversal(ctx, models.TransferReversal{
+		TransactionId: transfer.Id,
+		ReversalId:    reversalId,
+		ReversedBy:    admin,
+		Reason:        reason,
+		Forced:        force,
+	})
+	if err != nil {
+		_ = tx.Rollback(ctx)
+		return models.TransferReversal{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		svc.log.Error().Err(err).Msg("failed to commit transaction")
+		return models.TransferReversal{}, err
+	}
+
+	return reversal, nil
+}
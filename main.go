@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"log"
+	"os"
 
 	"github.com/MaksimovDenis/Avito_merch_shop/internal/app"
 )
@@ -15,5 +16,18 @@ func main() {
 		log.Fatalf("failed to init app: %s", err.Error())
 	}
 
+	if len(os.Args) > 1 && os.Args[1] == "reconcile" {
+		ok, err := merchShop.RunReconciliation(ctx, os.Stdout)
+		if err != nil {
+			log.Fatalf("failed to reconcile balances: %s", err.Error())
+		}
+
+		if !ok {
+			os.Exit(1)
+		}
+
+		return
+	}
+
 	merchShop.Run()
 }
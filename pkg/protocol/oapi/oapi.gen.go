@@ -15,16 +15,92 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gin-gonic/gin"
 	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 const (
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
+// Defines values for GetApiPaymentRequestsParamsStatus.
+const (
+	GetApiPaymentRequestsParamsStatusApproved GetApiPaymentRequestsParamsStatus = "approved"
+	GetApiPaymentRequestsParamsStatusPending  GetApiPaymentRequestsParamsStatus = "pending"
+	GetApiPaymentRequestsParamsStatusRejected GetApiPaymentRequestsParamsStatus = "rejected"
+	GetApiPaymentRequestsParamsStatusReversed GetApiPaymentRequestsParamsStatus = "reversed"
+)
+
+// Defines values for GetApiProductsParamsOrder.
+const (
+	GetApiProductsParamsOrderAsc  GetApiProductsParamsOrder = "asc"
+	GetApiProductsParamsOrderDesc GetApiProductsParamsOrder = "desc"
+)
+
+// Defines values for GetApiProductsParamsSort.
+const (
+	GetApiProductsParamsSortName  GetApiProductsParamsSort = "name"
+	GetApiProductsParamsSortPrice GetApiProductsParamsSort = "price"
+)
+
+// Defines values for GetApiTransactionsParamsStatus.
+const (
+	GetApiTransactionsParamsStatusCompleted GetApiTransactionsParamsStatus = "completed"
+	GetApiTransactionsParamsStatusDeclined  GetApiTransactionsParamsStatus = "declined"
+	GetApiTransactionsParamsStatusExpired   GetApiTransactionsParamsStatus = "expired"
+	GetApiTransactionsParamsStatusPending   GetApiTransactionsParamsStatus = "pending"
+	GetApiTransactionsParamsStatusReversal  GetApiTransactionsParamsStatus = "reversal"
+	GetApiTransactionsParamsStatusReversed  GetApiTransactionsParamsStatus = "reversed"
+)
+
+// Defines values for PaymentRequestStatus.
+const (
+	PaymentRequestStatusApproved PaymentRequestStatus = "approved"
+	PaymentRequestStatusPending  PaymentRequestStatus = "pending"
+	PaymentRequestStatusRejected PaymentRequestStatus = "rejected"
+	PaymentRequestStatusReversed PaymentRequestStatus = "reversed"
+)
+
+// Defines values for PurchaseStatus.
+const (
+	PurchaseStatusCompleted PurchaseStatus = "completed"
+	PurchaseStatusRefunded  PurchaseStatus = "refunded"
+)
+
+// Defines values for SentCoinsStatus.
+const (
+	SentCoinsStatusCompleted SentCoinsStatus = "completed"
+	SentCoinsStatusPending   SentCoinsStatus = "pending"
+)
+
+// Defines values for TransactionDirection.
+const (
+	TransactionDirectionReceived TransactionDirection = "received"
+	TransactionDirectionSent     TransactionDirection = "sent"
+)
+
+// Defines values for TransactionStatus.
+const (
+	TransactionStatusCompleted TransactionStatus = "completed"
+	TransactionStatusDeclined  TransactionStatus = "declined"
+	TransactionStatusExpired   TransactionStatus = "expired"
+	TransactionStatusPending   TransactionStatus = "pending"
+	TransactionStatusReversal  TransactionStatus = "reversal"
+	TransactionStatusReversed  TransactionStatus = "reversed"
+)
+
+// Defines values for ValidationErrorDetailIn.
+const (
+	ValidationErrorDetailInBody   ValidationErrorDetailIn = "body"
+	ValidationErrorDetailInHeader ValidationErrorDetailIn = "header"
+	ValidationErrorDetailInPath   ValidationErrorDetailIn = "path"
+	ValidationErrorDetailInQuery  ValidationErrorDetailIn = "query"
+)
+
 // AuthRequest defines model for AuthRequest.
 type AuthRequest struct {
 	// Password Пароль для аутентификации.
@@ -40,10 +116,84 @@ type AuthResponse struct {
 	Token *string `json:"token,omitempty"`
 }
 
+// BatchRecipient defines model for BatchRecipient.
+type BatchRecipient struct {
+	// Amount Количество монет, которые необходимо отправить.
+	Amount int `json:"amount"`
+
+	// ToUser Имя пользователя, которому нужно отправить монеты.
+	ToUser string `json:"toUser"`
+}
+
+// BatchSendCoinRequest defines model for BatchSendCoinRequest.
+type BatchSendCoinRequest struct {
+	// Message Сообщение к переводу, общее для всех получателей.
+	Message *string `json:"message,omitempty"`
+
+	// Recipients Получатели и суммы перевода.
+	Recipients []BatchRecipient `json:"recipients"`
+}
+
+// BatchSendCoinResponse defines model for BatchSendCoinResponse.
+type BatchSendCoinResponse struct {
+	// Message Сообщение о выполненном переводе.
+	Message string `json:"message"`
+
+	// TransactionIds Идентификаторы созданных переводов в порядке получателей.
+	TransactionIds []int `json:"transactionIds"`
+}
+
+// CartItem defines model for CartItem.
+type CartItem struct {
+	// Price Цена одной единицы товара.
+	Price *int `json:"price,omitempty"`
+
+	// Quantity Количество предметов.
+	Quantity *int `json:"quantity,omitempty"`
+
+	// Total Стоимость позиции (цена * количество).
+	Total *int `json:"total,omitempty"`
+
+	// Type Тип предмета.
+	Type *string `json:"type,omitempty"`
+}
+
+// CartItemRequest defines model for CartItemRequest.
+type CartItemRequest struct {
+	// Item Название товара.
+	Item string `json:"item"`
+
+	// Quantity Количество единиц товара.
+	Quantity int `json:"quantity"`
+}
+
+// CartResponse defines model for CartResponse.
+type CartResponse struct {
+	Items *[]CartItem `json:"items,omitempty"`
+
+	// Total Итоговая стоимость корзины.
+	Total *int `json:"total,omitempty"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
-	// Errors Сообщение об ошибке, описывающее проблему.
-	Errors *string `json:"errors,omitempty"`
+	// Code Машиночитаемый код ошибки, не зависящий от языка, например insufficient_coins или transfer_daily_limit.
+	Code *string `json:"code,omitempty"`
+
+	// Details Ошибки отдельных полей, если запрос не соответствует схеме API.
+	Details *[]ValidationErrorDetail `json:"details,omitempty"`
+
+	// Error Сообщение об ошибке на языке из заголовка Accept-Language (ru или en, по умолчанию ru).
+	Error *string `json:"error,omitempty"`
+}
+
+// ExpiringCoins defines model for ExpiringCoins.
+type ExpiringCoins struct {
+	// Amount Количество монет.
+	Amount int `json:"amount"`
+
+	// ExpiresAt Время, когда монеты сгорят.
+	ExpiresAt time.Time `json:"expiresAt"`
 }
 
 // InfoResponse defines model for InfoResponse.
@@ -55,10 +205,14 @@ type InfoResponse struct {
 
 			// FromUser Имя пользователя, который отправил монеты.
 			FromUser *string `json:"fromUser,omitempty"`
+
+			// Messages Сообщения к переводам от этого пользователя.
+			Messages *[]string `json:"messages,omitempty"`
 		} `json:"received,omitempty"`
 		Sent *[]struct {
 			// Amount Количество отправленных монет.
-			Amount *int `json:"amount,omitempty"`
+			Amount *int             `json:"amount,omitempty"`
+			Status *SentCoinsStatus `json:"status,omitempty"`
 
 			// ToUser Имя пользователя, которому отправлены монеты.
 			ToUser *string `json:"toUser,omitempty"`
@@ -66,8 +220,11 @@ type InfoResponse struct {
 	} `json:"coinHistory,omitempty"`
 
 	// Coins Количество доступных монет.
-	Coins     *int `json:"coins,omitempty"`
-	Inventory *[]struct {
+	Coins *int `json:"coins,omitempty"`
+
+	// ExpiresSoon Монеты, которые сгорят в ближайшие 30 дней, если их не потратить.
+	ExpiresSoon *[]ExpiringCoins `json:"expiresSoon,omitempty"`
+	Inventory   *[]struct {
 		// Quantity Количество предметов.
 		Quantity *int `json:"quantity,omitempty"`
 
@@ -76,21 +233,450 @@ type InfoResponse struct {
 	} `json:"inventory,omitempty"`
 }
 
+// IssuanceReport defines model for IssuanceReport.
+type IssuanceReport struct {
+	// Applied Монеты начислены.
+	Applied bool `json:"applied"`
+
+	// DryRun Запрос выполнен в режиме проверки.
+	DryRun bool `json:"dryRun"`
+
+	// Errors Количество строк с ошибками.
+	Errors int           `json:"errors"`
+	Rows   []IssuanceRow `json:"rows"`
+
+	// Total Количество строк в файле.
+	Total int `json:"total"`
+}
+
+// IssuanceRow defines model for IssuanceRow.
+type IssuanceRow struct {
+	// Amount Количество монет.
+	Amount int `json:"amount"`
+
+	// Error Ошибка в строке, если строка не прошла проверку.
+	Error *string `json:"error,omitempty"`
+
+	// ErrorCode Машиночитаемый код ошибки в строке.
+	ErrorCode *string `json:"errorCode,omitempty"`
+
+	// Line Номер строки в файле.
+	Line int `json:"line"`
+
+	// Reason Причина начисления.
+	Reason string `json:"reason"`
+
+	// Username Имя получателя.
+	Username string `json:"username"`
+}
+
+// PaymentRequest defines model for PaymentRequest.
+type PaymentRequest struct {
+	// Amount Запрашиваемое количество монет.
+	Amount *int `json:"amount,omitempty"`
+
+	// CreatedAt Время создания запроса.
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// FromUser Имя пользователя, который запросил монеты.
+	FromUser *string `json:"fromUser,omitempty"`
+
+	// Id Идентификатор запроса.
+	Id *int `json:"id,omitempty"`
+
+	// Reason Причина запроса.
+	Reason *string `json:"reason,omitempty"`
+
+	// Status Статус запроса. Запрос получает статус reversed, если администратор отменил перевод, которым он был оплачен.
+	Status *PaymentRequestStatus `json:"status,omitempty"`
+}
+
+// PaymentRequestCreateRequest defines model for PaymentRequestCreateRequest.
+type PaymentRequestCreateRequest struct {
+	// Amount Запрашиваемое количество монет.
+	Amount int `json:"amount"`
+
+	// FromUser Имя пользователя, у которого запрашиваются монеты.
+	FromUser string `json:"fromUser"`
+
+	// Reason Причина запроса. При одобрении становится сообщением к переводу.
+	Reason *string `json:"reason,omitempty"`
+}
+
+// PaymentRequestStatus Статус запроса. Запрос получает статус reversed, если администратор отменил перевод, которым он был оплачен.
+type PaymentRequestStatus string
+
+// PaymentRequestsResponse defines model for PaymentRequestsResponse.
+type PaymentRequestsResponse struct {
+	Items *[]PaymentRequest `json:"items,omitempty"`
+}
+
+// Product defines model for Product.
+type Product struct {
+	// Available Доступен ли товар для покупки.
+	Available *bool `json:"available,omitempty"`
+
+	// Name Название товара.
+	Name *string `json:"name,omitempty"`
+
+	// Price Цена товара в монетах.
+	Price *int `json:"price,omitempty"`
+
+	// Stock Остаток товара на складе. Отсутствует, если количество не ограничено.
+	Stock *int `json:"stock,omitempty"`
+}
+
+// ProductCreateRequest defines model for ProductCreateRequest.
+type ProductCreateRequest struct {
+	// Available Доступен ли товар для покупки (по умолчанию true).
+	Available *bool `json:"available,omitempty"`
+
+	// Name Название товара.
+	Name string `json:"name"`
+
+	// Price Цена товара в монетах.
+	Price int `json:"price"`
+
+	// Stock Остаток товара на складе. Если не передан, количество не ограничено.
+	Stock *int `json:"stock,omitempty"`
+}
+
+// ProductUpdateRequest defines model for ProductUpdateRequest.
+type ProductUpdateRequest struct {
+	// Available Доступен ли товар для покупки.
+	Available *bool `json:"available,omitempty"`
+
+	// Name Новое название товара.
+	Name *string `json:"name,omitempty"`
+
+	// Price Новая цена товара в монетах.
+	Price *int `json:"price,omitempty"`
+
+	// Stock Новый остаток товара на складе.
+	Stock *int `json:"stock,omitempty"`
+
+	// UnlimitedStock Снять ограничение на количество товара.
+	UnlimitedStock *bool `json:"unlimitedStock,omitempty"`
+}
+
+// ProductsResponse defines model for ProductsResponse.
+type ProductsResponse struct {
+	Items *[]Product `json:"items,omitempty"`
+
+	// Total Общее количество товаров в каталоге.
+	Total *int `json:"total,omitempty"`
+}
+
+// Purchase defines model for Purchase.
+type Purchase struct {
+	// Id Идентификатор покупки.
+	Id *int `json:"id,omitempty"`
+
+	// Name Название товара.
+	Name *string `json:"name,omitempty"`
+
+	// PurchasedAt Время покупки.
+	PurchasedAt *time.Time `json:"purchasedAt,omitempty"`
+
+	// Quantity Количество купленных единиц товара.
+	Quantity *int `json:"quantity,omitempty"`
+
+	// Status Статус покупки.
+	Status *PurchaseStatus `json:"status,omitempty"`
+
+	// TotalPrice Сумма, уплаченная за покупку.
+	TotalPrice *int `json:"totalPrice,omitempty"`
+
+	// UnitPrice Цена единицы товара на момент покупки.
+	UnitPrice *int `json:"unitPrice,omitempty"`
+}
+
+// PurchaseStatus Статус покупки.
+type PurchaseStatus string
+
+// PurchasesResponse defines model for PurchasesResponse.
+type PurchasesResponse struct {
+	Items *[]Purchase `json:"items,omitempty"`
+
+	// Spent Сумма, потраченная на невозвращённые покупки.
+	Spent *int `json:"spent,omitempty"`
+
+	// Total Общее количество покупок пользователя.
+	Total *int `json:"total,omitempty"`
+}
+
 // SendCoinRequest defines model for SendCoinRequest.
 type SendCoinRequest struct {
 	// Amount Количество монет, которые необходимо отправить.
 	Amount int `json:"amount"`
 
+	// Message Сообщение к переводу.
+	Message *string `json:"message,omitempty"`
+
+	// RequireAcceptance Перевод нужно подтвердить получателю. До подтверждения монеты удерживаются; если получатель отклонит перевод или не подтвердит его в срок, монеты вернутся отправителю.
+	RequireAcceptance *bool `json:"requireAcceptance,omitempty"`
+
 	// ToUser Имя пользователя, которому нужно отправить монеты.
 	ToUser string `json:"toUser"`
 }
 
+// SentCoinsStatus Состояние переводов. pending — переводы ожидают подтверждения получателем, монеты удерживаются.
+type SentCoinsStatus string
+
+// Transaction defines model for Transaction.
+type Transaction struct {
+	// Amount Сумма перевода.
+	Amount *int `json:"amount,omitempty"`
+
+	// Counterparty Имя второго участника перевода.
+	Counterparty *string `json:"counterparty,omitempty"`
+
+	// CreatedAt Время перевода.
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// Direction Направление перевода относительно текущего пользователя.
+	Direction *TransactionDirection `json:"direction,omitempty"`
+
+	// ExpiresAt Срок подтверждения перевода. Только для переводов с подтверждением.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// Id Идентификатор перевода.
+	Id *int `json:"id,omitempty"`
+
+	// Message Сообщение к переводу.
+	Message *string `json:"message,omitempty"`
+
+	// ReversalOf Идентификатор отменённого перевода. Только для переводов в статусе reversal.
+	ReversalOf *int `json:"reversalOf,omitempty"`
+
+	// Status Статус перевода. Перевод в статусе pending ожидает подтверждения получателем. Отменённый администратором перевод получает статус reversed, а возврат монет по нему записывается встречным переводом в статусе reversal.
+	Status *TransactionStatus `json:"status,omitempty"`
+}
+
+// TransactionDirection Направление перевода относительно текущего пользователя.
+type TransactionDirection string
+
+// TransactionStatus Статус перевода. Перевод в статусе pending ожидает подтверждения получателем. Отменённый администратором перевод получает статус reversed, а возврат монет по нему записывается встречным переводом в статусе reversal.
+type TransactionStatus string
+
+// TransactionsResponse defines model for TransactionsResponse.
+type TransactionsResponse struct {
+	Items *[]Transaction `json:"items,omitempty"`
+
+	// NextCursor Курсор следующей страницы. Отсутствует на последней странице.
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
+// TransferLimits Действующие лимиты переводов. Ноль означает, что лимит не ограничен.
+type TransferLimits struct {
+	// DailyLimit Максимальная сумма переводов за последние сутки.
+	DailyLimit *int `json:"dailyLimit,omitempty"`
+
+	// HourlyCount Максимальное количество переводов за последний час.
+	HourlyCount *int `json:"hourlyCount,omitempty"`
+
+	// MaxAmount Максимальная сумма одного перевода.
+	MaxAmount *int `json:"maxAmount,omitempty"`
+
+	// WeeklyLimit Максимальная сумма переводов за последние 7 дней.
+	WeeklyLimit *int `json:"weeklyLimit,omitempty"`
+}
+
+// TransferLimitsUpdateRequest Лимиты переводов пользователя. Незаданный лимит берётся из конфигурации, ноль снимает ограничение.
+type TransferLimitsUpdateRequest struct {
+	// DailyLimit Максимальная сумма переводов за последние сутки.
+	DailyLimit *int `json:"dailyLimit,omitempty"`
+
+	// HourlyCount Максимальное количество переводов за последний час.
+	HourlyCount *int `json:"hourlyCount,omitempty"`
+
+	// MaxAmount Максимальная сумма одного перевода.
+	MaxAmount *int `json:"maxAmount,omitempty"`
+
+	// WeeklyLimit Максимальная сумма переводов за последние 7 дней.
+	WeeklyLimit *int `json:"weeklyLimit,omitempty"`
+}
+
+// TransferReversal defines model for TransferReversal.
+type TransferReversal struct {
+	// CreatedAt Время отмены.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Forced Отмена выполнена с force.
+	Forced bool `json:"forced"`
+
+	// Id Идентификатор отмены.
+	Id int `json:"id"`
+
+	// Reason Причина отмены.
+	Reason string `json:"reason"`
+
+	// ReversalId Идентификатор встречного перевода.
+	ReversalId int `json:"reversalId"`
+
+	// ReversedBy Администратор, отменивший перевод.
+	ReversedBy string `json:"reversedBy"`
+
+	// TransactionId Идентификатор отменённого перевода.
+	TransactionId int `json:"transactionId"`
+}
+
+// TransferReversalRequest defines model for TransferReversalRequest.
+type TransferReversalRequest struct {
+	// Force Отменить перевод, даже если баланс получателя станет отрицательным.
+	Force *bool `json:"force,omitempty"`
+
+	// Reason Причина отмены перевода.
+	Reason string `json:"reason"`
+}
+
+// ValidationErrorDetail defines model for ValidationErrorDetail.
+type ValidationErrorDetail struct {
+	// Field Имя параметра или путь к полю тела запроса через точку.
+	Field *string `json:"field,omitempty"`
+
+	// In Часть запроса, в которой найдена ошибка.
+	In ValidationErrorDetailIn `json:"in"`
+
+	// Message Описание ошибки.
+	Message string `json:"message"`
+}
+
+// ValidationErrorDetailIn Часть запроса, в которой найдена ошибка.
+type ValidationErrorDetailIn string
+
+// PostApiAdminCoinsIssuancesParams defines parameters for PostApiAdminCoinsIssuances.
+type PostApiAdminCoinsIssuancesParams struct {
+	// DryRun Только проверить файл, не начисляя монеты.
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// GetApiBuyItemParams defines parameters for GetApiBuyItem.
+type GetApiBuyItemParams struct {
+	// Quantity Количество покупаемых единиц товара (по умолчанию 1).
+	Quantity *int `form:"quantity,omitempty" json:"quantity,omitempty"`
+
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом возвращает результат первого запроса и не выполняется заново.
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
+}
+
+// GetApiPaymentRequestsParams defines parameters for GetApiPaymentRequests.
+type GetApiPaymentRequestsParams struct {
+	// Status Статус запроса.
+	Status *GetApiPaymentRequestsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Limit Количество запросов на странице (по умолчанию 20, максимум 100).
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Смещение от начала списка (по умолчанию 0).
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetApiPaymentRequestsParamsStatus defines parameters for GetApiPaymentRequests.
+type GetApiPaymentRequestsParamsStatus string
+
+// GetApiProductsParams defines parameters for GetApiProducts.
+type GetApiProductsParams struct {
+	// Sort Поле сортировки (по умолчанию name).
+	Sort *GetApiProductsParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order Направление сортировки (по умолчанию asc).
+	Order *GetApiProductsParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Limit Количество товаров на странице (по умолчанию 20, максимум 100).
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Смещение от начала списка (по умолчанию 0).
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetApiProductsParamsSort defines parameters for GetApiProducts.
+type GetApiProductsParamsSort string
+
+// GetApiProductsParamsOrder defines parameters for GetApiProducts.
+type GetApiProductsParamsOrder string
+
+// GetApiPurchasesParams defines parameters for GetApiPurchases.
+type GetApiPurchasesParams struct {
+	// Limit Количество покупок на странице (по умолчанию 20, максимум 100).
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Смещение от начала списка (по умолчанию 0).
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// PostApiSendCoinParams defines parameters for PostApiSendCoin.
+type PostApiSendCoinParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом возвращает результат первого запроса и не выполняется заново.
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
+}
+
+// PostApiSendCoinBatchParams defines parameters for PostApiSendCoinBatch.
+type PostApiSendCoinBatchParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом возвращает результат первого запроса и не выполняется заново.
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
+}
+
+// GetApiTransactionsParams defines parameters for GetApiTransactions.
+type GetApiTransactionsParams struct {
+	// Cursor Курсор следующей страницы из поля nextCursor предыдущего ответа.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Количество переводов на странице (по умолчанию 20, максимум 100).
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// From Начало периода (включительно).
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец периода (не включительно).
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Counterparty Имя пользователя, с которым выполнялись переводы.
+	Counterparty *string `form:"counterparty,omitempty" json:"counterparty,omitempty"`
+
+	// Status Статус перевода.
+	Status *GetApiTransactionsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// GetApiTransactionsParamsStatus defines parameters for GetApiTransactions.
+type GetApiTransactionsParamsStatus string
+
+// PostApiAdminCoinsIssuancesMultipartBody defines parameters for PostApiAdminCoinsIssuances.
+type PostApiAdminCoinsIssuancesMultipartBody struct {
+	// File CSV-файл начислений.
+	File openapi_types.File `json:"file"`
+}
+
+// PostApiAdminCoinsIssuancesMultipartRequestBody defines body for PostApiAdminCoinsIssuances for multipart/form-data ContentType.
+type PostApiAdminCoinsIssuancesMultipartRequestBody = PostApiAdminCoinsIssuancesMultipartBody
+
+// PostApiAdminProductsJSONRequestBody defines body for PostApiAdminProducts for application/json ContentType.
+type PostApiAdminProductsJSONRequestBody = ProductCreateRequest
+
+// PatchApiAdminProductsNameJSONRequestBody defines body for PatchApiAdminProductsName for application/json ContentType.
+type PatchApiAdminProductsNameJSONRequestBody = ProductUpdateRequest
+
+// PostApiAdminTransactionsIdReverseJSONRequestBody defines body for PostApiAdminTransactionsIdReverse for application/json ContentType.
+type PostApiAdminTransactionsIdReverseJSONRequestBody = TransferReversalRequest
+
+// PutApiAdminUsersUsernameTransferLimitsJSONRequestBody defines body for PutApiAdminUsersUsernameTransferLimits for application/json ContentType.
+type PutApiAdminUsersUsernameTransferLimitsJSONRequestBody = TransferLimitsUpdateRequest
+
 // PostApiAuthJSONRequestBody defines body for PostApiAuth for application/json ContentType.
 type PostApiAuthJSONRequestBody = AuthRequest
 
+// PostApiCartItemsJSONRequestBody defines body for PostApiCartItems for application/json ContentType.
+type PostApiCartItemsJSONRequestBody = CartItemRequest
+
+// PostApiPaymentRequestsJSONRequestBody defines body for PostApiPaymentRequests for application/json ContentType.
+type PostApiPaymentRequestsJSONRequestBody = PaymentRequestCreateRequest
+
 // PostApiSendCoinJSONRequestBody defines body for PostApiSendCoin for application/json ContentType.
 type PostApiSendCoinJSONRequestBody = SendCoinRequest
 
+// PostApiSendCoinBatchJSONRequestBody defines body for PostApiSendCoinBatch for application/json ContentType.
+type PostApiSendCoinBatchJSONRequestBody = BatchSendCoinRequest
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -164,25 +750,105 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// PostApiAdminCoinsIssuancesWithBody request with any body
+	PostApiAdminCoinsIssuancesWithBody(ctx context.Context, params *PostApiAdminCoinsIssuancesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiAdminProductsWithBody request with any body
+	PostApiAdminProductsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiAdminProducts(ctx context.Context, body PostApiAdminProductsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiAdminProductsName request
+	DeleteApiAdminProductsName(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchApiAdminProductsNameWithBody request with any body
+	PatchApiAdminProductsNameWithBody(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchApiAdminProductsName(ctx context.Context, name string, body PatchApiAdminProductsNameJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiAdminTransactionsIdReverseWithBody request with any body
+	PostApiAdminTransactionsIdReverseWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiAdminTransactionsIdReverse(ctx context.Context, id int, body PostApiAdminTransactionsIdReverseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiAdminUsersUsernameTransferLimits request
+	GetApiAdminUsersUsernameTransferLimits(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutApiAdminUsersUsernameTransferLimitsWithBody request with any body
+	PutApiAdminUsersUsernameTransferLimitsWithBody(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutApiAdminUsersUsernameTransferLimits(ctx context.Context, username string, body PutApiAdminUsersUsernameTransferLimitsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostApiAuthWithBody request with any body
 	PostApiAuthWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PostApiAuth(ctx context.Context, body PostApiAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetApiBuyItem request
-	GetApiBuyItem(ctx context.Context, item string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetApiBuyItem(ctx context.Context, item string, params *GetApiBuyItemParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiCart request
+	GetApiCart(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiCartCheckout request
+	PostApiCartCheckout(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiCartItemsWithBody request with any body
+	PostApiCartItemsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiCartItems(ctx context.Context, body PostApiCartItemsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiCartItemsItem request
+	DeleteApiCartItemsItem(ctx context.Context, item string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetApiInfo request
 	GetApiInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetApiPaymentRequests request
+	GetApiPaymentRequests(ctx context.Context, params *GetApiPaymentRequestsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiPaymentRequestsWithBody request with any body
+	PostApiPaymentRequestsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiPaymentRequests(ctx context.Context, body PostApiPaymentRequestsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiPaymentRequestsIdApprove request
+	PostApiPaymentRequestsIdApprove(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiPaymentRequestsIdReject request
+	PostApiPaymentRequestsIdReject(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiProducts request
+	GetApiProducts(ctx context.Context, params *GetApiProductsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiPurchases request
+	GetApiPurchases(ctx context.Context, params *GetApiPurchasesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiPurchasesIdRefund request
+	PostApiPurchasesIdRefund(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostApiSendCoinWithBody request with any body
-	PostApiSendCoinWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	PostApiSendCoinWithBody(ctx context.Context, params *PostApiSendCoinParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiSendCoin(ctx context.Context, params *PostApiSendCoinParams, body PostApiSendCoinJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiSendCoinBatchWithBody request with any body
+	PostApiSendCoinBatchWithBody(ctx context.Context, params *PostApiSendCoinBatchParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	PostApiSendCoin(ctx context.Context, body PostApiSendCoinJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	PostApiSendCoinBatch(ctx context.Context, params *PostApiSendCoinBatchParams, body PostApiSendCoinBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiTransactions request
+	GetApiTransactions(ctx context.Context, params *GetApiTransactionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiTransactionsIdAccept request
+	PostApiTransactionsIdAccept(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiTransactionsIdDecline request
+	PostApiTransactionsIdDecline(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) PostApiAuthWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiAuthRequestWithBody(c.Server, contentType, body)
+func (c *Client) PostApiAdminCoinsIssuancesWithBody(ctx context.Context, params *PostApiAdminCoinsIssuancesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminCoinsIssuancesRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -193,8 +859,8 @@ func (c *Client) PostApiAuthWithBody(ctx context.Context, contentType string, bo
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostApiAuth(ctx context.Context, body PostApiAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiAuthRequest(c.Server, body)
+func (c *Client) PostApiAdminProductsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminProductsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -205,8 +871,8 @@ func (c *Client) PostApiAuth(ctx context.Context, body PostApiAuthJSONRequestBod
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetApiBuyItem(ctx context.Context, item string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetApiBuyItemRequest(c.Server, item)
+func (c *Client) PostApiAdminProducts(ctx context.Context, body PostApiAdminProductsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminProductsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -217,8 +883,8 @@ func (c *Client) GetApiBuyItem(ctx context.Context, item string, reqEditors ...R
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetApiInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetApiInfoRequest(c.Server)
+func (c *Client) DeleteApiAdminProductsName(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiAdminProductsNameRequest(c.Server, name)
 	if err != nil {
 		return nil, err
 	}
@@ -229,8 +895,8 @@ func (c *Client) GetApiInfo(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostApiSendCoinWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiSendCoinRequestWithBody(c.Server, contentType, body)
+func (c *Client) PatchApiAdminProductsNameWithBody(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchApiAdminProductsNameRequestWithBody(c.Server, name, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -241,8 +907,8 @@ func (c *Client) PostApiSendCoinWithBody(ctx context.Context, contentType string
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostApiSendCoin(ctx context.Context, body PostApiSendCoinJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiSendCoinRequest(c.Server, body)
+func (c *Client) PatchApiAdminProductsName(ctx context.Context, name string, body PatchApiAdminProductsNameJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchApiAdminProductsNameRequest(c.Server, name, body)
 	if err != nil {
 		return nil, err
 	}
@@ -253,561 +919,4894 @@ func (c *Client) PostApiSendCoin(ctx context.Context, body PostApiSendCoinJSONRe
 	return c.Client.Do(req)
 }
 
-// NewPostApiAuthRequest calls the generic PostApiAuth builder with application/json body
-func NewPostApiAuthRequest(server string, body PostApiAuthJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostApiAdminTransactionsIdReverseWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminTransactionsIdReverseRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostApiAuthRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostApiAuthRequestWithBody generates requests for PostApiAuth with any type of body
-func NewPostApiAuthRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostApiAdminTransactionsIdReverse(ctx context.Context, id int, body PostApiAdminTransactionsIdReverseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminTransactionsIdReverseRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/auth")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetApiAdminUsersUsernameTransferLimits(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiAdminUsersUsernameTransferLimitsRequest(c.Server, username)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) PutApiAdminUsersUsernameTransferLimitsWithBody(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiAdminUsersUsernameTransferLimitsRequestWithBody(c.Server, username, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetApiBuyItemRequest generates requests for GetApiBuyItem
-func NewGetApiBuyItemRequest(server string, item string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "item", runtime.ParamLocationPath, item)
+func (c *Client) PutApiAdminUsersUsernameTransferLimits(ctx context.Context, username string, body PutApiAdminUsersUsernameTransferLimitsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiAdminUsersUsernameTransferLimitsRequest(c.Server, username, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PostApiAuthWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAuthRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/buy/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostApiAuth(ctx context.Context, body PostApiAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAuthRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) GetApiBuyItem(ctx context.Context, item string, params *GetApiBuyItemParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiBuyItemRequest(c.Server, item, params)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetApiInfoRequest generates requests for GetApiInfo
-func NewGetApiInfoRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetApiCart(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiCartRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/info")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostApiCartCheckout(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiCartCheckoutRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) PostApiCartItemsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiCartItemsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostApiSendCoinRequest calls the generic PostApiSendCoin builder with application/json body
-func NewPostApiSendCoinRequest(server string, body PostApiSendCoinJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostApiCartItems(ctx context.Context, body PostApiCartItemsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiCartItemsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostApiSendCoinRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostApiSendCoinRequestWithBody generates requests for PostApiSendCoin with any type of body
-func NewPostApiSendCoinRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) DeleteApiCartItemsItem(ctx context.Context, item string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiCartItemsItemRequest(c.Server, item)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/sendCoin")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetApiInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiInfoRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) GetApiPaymentRequests(ctx context.Context, params *GetApiPaymentRequestsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiPaymentRequestsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+func (c *Client) PostApiPaymentRequestsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiPaymentRequestsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return nil
+	return c.Client.Do(req)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+func (c *Client) PostApiPaymentRequests(ctx context.Context, body PostApiPaymentRequestsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiPaymentRequestsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+func (c *Client) PostApiPaymentRequestsIdApprove(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiPaymentRequestsIdApproveRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+func (c *Client) PostApiPaymentRequestsIdReject(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiPaymentRequestsIdRejectRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// PostApiAuthWithBodyWithResponse request with any body
-	PostApiAuthWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAuthResponse, error)
-
-	PostApiAuthWithResponse(ctx context.Context, body PostApiAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAuthResponse, error)
-
-	// GetApiBuyItemWithResponse request
-	GetApiBuyItemWithResponse(ctx context.Context, item string, reqEditors ...RequestEditorFn) (*GetApiBuyItemResponse, error)
-
-	// GetApiInfoWithResponse request
-	GetApiInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiInfoResponse, error)
-
-	// PostApiSendCoinWithBodyWithResponse request with any body
-	PostApiSendCoinWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiSendCoinResponse, error)
-
-	PostApiSendCoinWithResponse(ctx context.Context, body PostApiSendCoinJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiSendCoinResponse, error)
+func (c *Client) GetApiProducts(ctx context.Context, params *GetApiProductsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiProductsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type PostApiAuthResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AuthResponse
-	JSON400      *ErrorResponse
-	JSON401      *ErrorResponse
-	JSON500      *ErrorResponse
+func (c *Client) GetApiPurchases(ctx context.Context, params *GetApiPurchasesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiPurchasesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostApiAuthResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) PostApiPurchasesIdRefund(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiPurchasesIdRefundRequest(c.Server, id)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostApiAuthResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) PostApiSendCoinWithBody(ctx context.Context, params *PostApiSendCoinParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiSendCoinRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type GetApiBuyItemResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *ErrorResponse
-	JSON401      *ErrorResponse
-	JSON500      *ErrorResponse
+func (c *Client) PostApiSendCoin(ctx context.Context, params *PostApiSendCoinParams, body PostApiSendCoinJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiSendCoinRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetApiBuyItemResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) PostApiSendCoinBatchWithBody(ctx context.Context, params *PostApiSendCoinBatchParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiSendCoinBatchRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiBuyItemResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) PostApiSendCoinBatch(ctx context.Context, params *PostApiSendCoinBatchParams, body PostApiSendCoinBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiSendCoinBatchRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type GetApiInfoResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *InfoResponse
-	JSON400      *ErrorResponse
-	JSON401      *ErrorResponse
-	JSON500      *ErrorResponse
+func (c *Client) GetApiTransactions(ctx context.Context, params *GetApiTransactionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiTransactionsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetApiInfoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) PostApiTransactionsIdAccept(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiTransactionsIdAcceptRequest(c.Server, id)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiInfoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) PostApiTransactionsIdDecline(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiTransactionsIdDeclineRequest(c.Server, id)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type PostApiSendCoinResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *ErrorResponse
-	JSON401      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+// NewPostApiAdminCoinsIssuancesRequestWithBody generates requests for PostApiAdminCoinsIssuances with any type of body
+func NewPostApiAdminCoinsIssuancesRequestWithBody(server string, params *PostApiAdminCoinsIssuancesParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r PostApiSendCoinResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostApiSendCoinResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/api/admin/coins/issuances")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.DryRun != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dryRun", runtime.ParamLocationQuery, *params.DryRun); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// PostApiAuthWithBodyWithResponse request with arbitrary body returning *PostApiAuthResponse
-func (c *ClientWithResponses) PostApiAuthWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAuthResponse, error) {
-	rsp, err := c.PostApiAuthWithBody(ctx, contentType, body, reqEditors...)
+// NewPostApiAdminProductsRequest calls the generic PostApiAdminProducts builder with application/json body
+func NewPostApiAdminProductsRequest(server string, body PostApiAdminProductsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostApiAuthResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiAdminProductsRequestWithBody(server, "application/json", bodyReader)
 }
 
-func (c *ClientWithResponses) PostApiAuthWithResponse(ctx context.Context, body PostApiAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAuthResponse, error) {
-	rsp, err := c.PostApiAuth(ctx, body, reqEditors...)
+// NewPostApiAdminProductsRequestWithBody generates requests for PostApiAdminProducts with any type of body
+func NewPostApiAdminProductsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostApiAuthResponse(rsp)
+
+	operationPath := fmt.Sprintf("/api/admin/products")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// GetApiBuyItemWithResponse request returning *GetApiBuyItemResponse
-func (c *ClientWithResponses) GetApiBuyItemWithResponse(ctx context.Context, item string, reqEditors ...RequestEditorFn) (*GetApiBuyItemResponse, error) {
-	rsp, err := c.GetApiBuyItem(ctx, item, reqEditors...)
+// NewDeleteApiAdminProductsNameRequest generates requests for DeleteApiAdminProductsName
+func NewDeleteApiAdminProductsNameRequest(server string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetApiBuyItemResponse(rsp)
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/products/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// GetApiInfoWithResponse request returning *GetApiInfoResponse
-func (c *ClientWithResponses) GetApiInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiInfoResponse, error) {
-	rsp, err := c.GetApiInfo(ctx, reqEditors...)
+// NewPatchApiAdminProductsNameRequest calls the generic PatchApiAdminProductsName builder with application/json body
+func NewPatchApiAdminProductsNameRequest(server string, name string, body PatchApiAdminProductsNameJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetApiInfoResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchApiAdminProductsNameRequestWithBody(server, name, "application/json", bodyReader)
 }
 
-// PostApiSendCoinWithBodyWithResponse request with arbitrary body returning *PostApiSendCoinResponse
-func (c *ClientWithResponses) PostApiSendCoinWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiSendCoinResponse, error) {
-	rsp, err := c.PostApiSendCoinWithBody(ctx, contentType, body, reqEditors...)
+// NewPatchApiAdminProductsNameRequestWithBody generates requests for PatchApiAdminProductsName with any type of body
+func NewPatchApiAdminProductsNameRequestWithBody(server string, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostApiSendCoinResponse(rsp)
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/products/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-func (c *ClientWithResponses) PostApiSendCoinWithResponse(ctx context.Context, body PostApiSendCoinJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiSendCoinResponse, error) {
-	rsp, err := c.PostApiSendCoin(ctx, body, reqEditors...)
+// NewPostApiAdminTransactionsIdReverseRequest calls the generic PostApiAdminTransactionsIdReverse builder with application/json body
+func NewPostApiAdminTransactionsIdReverseRequest(server string, id int, body PostApiAdminTransactionsIdReverseJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostApiSendCoinResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiAdminTransactionsIdReverseRequestWithBody(server, id, "application/json", bodyReader)
 }
 
-// ParsePostApiAuthResponse parses an HTTP response from a PostApiAuthWithResponse call
-func ParsePostApiAuthResponse(rsp *http.Response) (*PostApiAuthResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewPostApiAdminTransactionsIdReverseRequestWithBody generates requests for PostApiAdminTransactionsIdReverse with any type of body
+func NewPostApiAdminTransactionsIdReverseRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostApiAuthResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AuthResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	operationPath := fmt.Sprintf("/api/admin/transactions/%s/reverse", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiAdminUsersUsernameTransferLimitsRequest generates requests for GetApiAdminUsersUsernameTransferLimits
+func NewGetApiAdminUsersUsernameTransferLimitsRequest(server string, username string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/users/%s/transferLimits", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutApiAdminUsersUsernameTransferLimitsRequest calls the generic PutApiAdminUsersUsernameTransferLimits builder with application/json body
+func NewPutApiAdminUsersUsernameTransferLimitsRequest(server string, username string, body PutApiAdminUsersUsernameTransferLimitsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiAdminUsersUsernameTransferLimitsRequestWithBody(server, username, "application/json", bodyReader)
+}
+
+// NewPutApiAdminUsersUsernameTransferLimitsRequestWithBody generates requests for PutApiAdminUsersUsernameTransferLimits with any type of body
+func NewPutApiAdminUsersUsernameTransferLimitsRequestWithBody(server string, username string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/users/%s/transferLimits", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiAuthRequest calls the generic PostApiAuth builder with application/json body
+func NewPostApiAuthRequest(server string, body PostApiAuthJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiAuthRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiAuthRequestWithBody generates requests for PostApiAuth with any type of body
+func NewPostApiAuthRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiBuyItemRequest generates requests for GetApiBuyItem
+func NewGetApiBuyItemRequest(server string, item string, params *GetApiBuyItemParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "item", runtime.ParamLocationPath, item)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/buy/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Quantity != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "quantity", runtime.ParamLocationQuery, *params.Quantity); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetApiCartRequest generates requests for GetApiCart
+func NewGetApiCartRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/cart")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiCartCheckoutRequest generates requests for PostApiCartCheckout
+func NewPostApiCartCheckoutRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/cart/checkout")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiCartItemsRequest calls the generic PostApiCartItems builder with application/json body
+func NewPostApiCartItemsRequest(server string, body PostApiCartItemsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiCartItemsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiCartItemsRequestWithBody generates requests for PostApiCartItems with any type of body
+func NewPostApiCartItemsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/cart/items")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteApiCartItemsItemRequest generates requests for DeleteApiCartItemsItem
+func NewDeleteApiCartItemsItemRequest(server string, item string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "item", runtime.ParamLocationPath, item)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/cart/items/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiInfoRequest generates requests for GetApiInfo
+func NewGetApiInfoRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/info")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiPaymentRequestsRequest generates requests for GetApiPaymentRequests
+func NewGetApiPaymentRequestsRequest(server string, params *GetApiPaymentRequestsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/paymentRequests")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiPaymentRequestsRequest calls the generic PostApiPaymentRequests builder with application/json body
+func NewPostApiPaymentRequestsRequest(server string, body PostApiPaymentRequestsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiPaymentRequestsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiPaymentRequestsRequestWithBody generates requests for PostApiPaymentRequests with any type of body
+func NewPostApiPaymentRequestsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/paymentRequests")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiPaymentRequestsIdApproveRequest generates requests for PostApiPaymentRequestsIdApprove
+func NewPostApiPaymentRequestsIdApproveRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/paymentRequests/%s/approve", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiPaymentRequestsIdRejectRequest generates requests for PostApiPaymentRequestsIdReject
+func NewPostApiPaymentRequestsIdRejectRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/paymentRequests/%s/reject", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiProductsRequest generates requests for GetApiProducts
+func NewGetApiProductsRequest(server string, params *GetApiProductsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/products")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiPurchasesRequest generates requests for GetApiPurchases
+func NewGetApiPurchasesRequest(server string, params *GetApiPurchasesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/purchases")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiPurchasesIdRefundRequest generates requests for PostApiPurchasesIdRefund
+func NewPostApiPurchasesIdRefundRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/purchases/%s/refund", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiSendCoinRequest calls the generic PostApiSendCoin builder with application/json body
+func NewPostApiSendCoinRequest(server string, params *PostApiSendCoinParams, body PostApiSendCoinJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiSendCoinRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostApiSendCoinRequestWithBody generates requests for PostApiSendCoin with any type of body
+func NewPostApiSendCoinRequestWithBody(server string, params *PostApiSendCoinParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/sendCoin")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostApiSendCoinBatchRequest calls the generic PostApiSendCoinBatch builder with application/json body
+func NewPostApiSendCoinBatchRequest(server string, params *PostApiSendCoinBatchParams, body PostApiSendCoinBatchJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiSendCoinBatchRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostApiSendCoinBatchRequestWithBody generates requests for PostApiSendCoinBatch with any type of body
+func NewPostApiSendCoinBatchRequestWithBody(server string, params *PostApiSendCoinBatchParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/sendCoin/batch")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetApiTransactionsRequest generates requests for GetApiTransactions
+func NewGetApiTransactionsRequest(server string, params *GetApiTransactionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/transactions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Counterparty != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "counterparty", runtime.ParamLocationQuery, *params.Counterparty); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiTransactionsIdAcceptRequest generates requests for PostApiTransactionsIdAccept
+func NewPostApiTransactionsIdAcceptRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/transactions/%s/accept", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiTransactionsIdDeclineRequest generates requests for PostApiTransactionsIdDecline
+func NewPostApiTransactionsIdDeclineRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/transactions/%s/decline", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// PostApiAdminCoinsIssuancesWithBodyWithResponse request with any body
+	PostApiAdminCoinsIssuancesWithBodyWithResponse(ctx context.Context, params *PostApiAdminCoinsIssuancesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAdminCoinsIssuancesResponse, error)
+
+	// PostApiAdminProductsWithBodyWithResponse request with any body
+	PostApiAdminProductsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAdminProductsResponse, error)
+
+	PostApiAdminProductsWithResponse(ctx context.Context, body PostApiAdminProductsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAdminProductsResponse, error)
+
+	// DeleteApiAdminProductsNameWithResponse request
+	DeleteApiAdminProductsNameWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*DeleteApiAdminProductsNameResponse, error)
+
+	// PatchApiAdminProductsNameWithBodyWithResponse request with any body
+	PatchApiAdminProductsNameWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchApiAdminProductsNameResponse, error)
+
+	PatchApiAdminProductsNameWithResponse(ctx context.Context, name string, body PatchApiAdminProductsNameJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchApiAdminProductsNameResponse, error)
+
+	// PostApiAdminTransactionsIdReverseWithBodyWithResponse request with any body
+	PostApiAdminTransactionsIdReverseWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAdminTransactionsIdReverseResponse, error)
+
+	PostApiAdminTransactionsIdReverseWithResponse(ctx context.Context, id int, body PostApiAdminTransactionsIdReverseJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAdminTransactionsIdReverseResponse, error)
+
+	// GetApiAdminUsersUsernameTransferLimitsWithResponse request
+	GetApiAdminUsersUsernameTransferLimitsWithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*GetApiAdminUsersUsernameTransferLimitsResponse, error)
+
+	// PutApiAdminUsersUsernameTransferLimitsWithBodyWithResponse request with any body
+	PutApiAdminUsersUsernameTransferLimitsWithBodyWithResponse(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiAdminUsersUsernameTransferLimitsResponse, error)
+
+	PutApiAdminUsersUsernameTransferLimitsWithResponse(ctx context.Context, username string, body PutApiAdminUsersUsernameTransferLimitsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiAdminUsersUsernameTransferLimitsResponse, error)
+
+	// PostApiAuthWithBodyWithResponse request with any body
+	PostApiAuthWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAuthResponse, error)
+
+	PostApiAuthWithResponse(ctx context.Context, body PostApiAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAuthResponse, error)
+
+	// GetApiBuyItemWithResponse request
+	GetApiBuyItemWithResponse(ctx context.Context, item string, params *GetApiBuyItemParams, reqEditors ...RequestEditorFn) (*GetApiBuyItemResponse, error)
+
+	// GetApiCartWithResponse request
+	GetApiCartWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiCartResponse, error)
+
+	// PostApiCartCheckoutWithResponse request
+	PostApiCartCheckoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostApiCartCheckoutResponse, error)
+
+	// PostApiCartItemsWithBodyWithResponse request with any body
+	PostApiCartItemsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiCartItemsResponse, error)
+
+	PostApiCartItemsWithResponse(ctx context.Context, body PostApiCartItemsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiCartItemsResponse, error)
+
+	// DeleteApiCartItemsItemWithResponse request
+	DeleteApiCartItemsItemWithResponse(ctx context.Context, item string, reqEditors ...RequestEditorFn) (*DeleteApiCartItemsItemResponse, error)
+
+	// GetApiInfoWithResponse request
+	GetApiInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiInfoResponse, error)
+
+	// GetApiPaymentRequestsWithResponse request
+	GetApiPaymentRequestsWithResponse(ctx context.Context, params *GetApiPaymentRequestsParams, reqEditors ...RequestEditorFn) (*GetApiPaymentRequestsResponse, error)
+
+	// PostApiPaymentRequestsWithBodyWithResponse request with any body
+	PostApiPaymentRequestsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiPaymentRequestsResponse, error)
+
+	PostApiPaymentRequestsWithResponse(ctx context.Context, body PostApiPaymentRequestsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiPaymentRequestsResponse, error)
+
+	// PostApiPaymentRequestsIdApproveWithResponse request
+	PostApiPaymentRequestsIdApproveWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*PostApiPaymentRequestsIdApproveResponse, error)
+
+	// PostApiPaymentRequestsIdRejectWithResponse request
+	PostApiPaymentRequestsIdRejectWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*PostApiPaymentRequestsIdRejectResponse, error)
+
+	// GetApiProductsWithResponse request
+	GetApiProductsWithResponse(ctx context.Context, params *GetApiProductsParams, reqEditors ...RequestEditorFn) (*GetApiProductsResponse, error)
+
+	// GetApiPurchasesWithResponse request
+	GetApiPurchasesWithResponse(ctx context.Context, params *GetApiPurchasesParams, reqEditors ...RequestEditorFn) (*GetApiPurchasesResponse, error)
+
+	// PostApiPurchasesIdRefundWithResponse request
+	PostApiPurchasesIdRefundWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*PostApiPurchasesIdRefundResponse, error)
+
+	// PostApiSendCoinWithBodyWithResponse request with any body
+	PostApiSendCoinWithBodyWithResponse(ctx context.Context, params *PostApiSendCoinParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiSendCoinResponse, error)
+
+	PostApiSendCoinWithResponse(ctx context.Context, params *PostApiSendCoinParams, body PostApiSendCoinJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiSendCoinResponse, error)
+
+	// PostApiSendCoinBatchWithBodyWithResponse request with any body
+	PostApiSendCoinBatchWithBodyWithResponse(ctx context.Context, params *PostApiSendCoinBatchParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiSendCoinBatchResponse, error)
+
+	PostApiSendCoinBatchWithResponse(ctx context.Context, params *PostApiSendCoinBatchParams, body PostApiSendCoinBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiSendCoinBatchResponse, error)
+
+	// GetApiTransactionsWithResponse request
+	GetApiTransactionsWithResponse(ctx context.Context, params *GetApiTransactionsParams, reqEditors ...RequestEditorFn) (*GetApiTransactionsResponse, error)
+
+	// PostApiTransactionsIdAcceptWithResponse request
+	PostApiTransactionsIdAcceptWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*PostApiTransactionsIdAcceptResponse, error)
+
+	// PostApiTransactionsIdDeclineWithResponse request
+	PostApiTransactionsIdDeclineWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*PostApiTransactionsIdDeclineResponse, error)
+}
+
+type PostApiAdminCoinsIssuancesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IssuanceReport
+	JSON400      *interface{}
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAdminCoinsIssuancesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAdminCoinsIssuancesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAdminProductsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Product
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAdminProductsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAdminProductsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiAdminProductsNameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Product
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiAdminProductsNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiAdminProductsNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchApiAdminProductsNameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Product
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchApiAdminProductsNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchApiAdminProductsNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAdminTransactionsIdReverseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TransferReversal
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAdminTransactionsIdReverseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAdminTransactionsIdReverseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiAdminUsersUsernameTransferLimitsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TransferLimits
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiAdminUsersUsernameTransferLimitsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiAdminUsersUsernameTransferLimitsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiAdminUsersUsernameTransferLimitsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TransferLimits
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiAdminUsersUsernameTransferLimitsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiAdminUsersUsernameTransferLimitsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAuthResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuthResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAuthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAuthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiBuyItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON422      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiBuyItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiBuyItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiCartResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CartResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiCartResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiCartResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiCartCheckoutResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON422      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiCartCheckoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiCartCheckoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiCartItemsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiCartItemsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiCartItemsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiCartItemsItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiCartItemsItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiCartItemsItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiInfoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InfoResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiInfoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiInfoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiPaymentRequestsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PaymentRequestsResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiPaymentRequestsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiPaymentRequestsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiPaymentRequestsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiPaymentRequestsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiPaymentRequestsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiPaymentRequestsIdApproveResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON422      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiPaymentRequestsIdApproveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiPaymentRequestsIdApproveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiPaymentRequestsIdRejectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiPaymentRequestsIdRejectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiPaymentRequestsIdRejectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiProductsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProductsResponse
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiProductsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiProductsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiPurchasesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PurchasesResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiPurchasesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiPurchasesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiPurchasesIdRefundResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiPurchasesIdRefundResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiPurchasesIdRefundResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiSendCoinResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON422      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiSendCoinResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiSendCoinResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiSendCoinBatchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BatchSendCoinResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON422      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiSendCoinBatchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiSendCoinBatchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiTransactionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TransactionsResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiTransactionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiTransactionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiTransactionsIdAcceptResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiTransactionsIdAcceptResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiTransactionsIdAcceptResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiTransactionsIdDeclineResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiTransactionsIdDeclineResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiTransactionsIdDeclineResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// PostApiAdminCoinsIssuancesWithBodyWithResponse request with arbitrary body returning *PostApiAdminCoinsIssuancesResponse
+func (c *ClientWithResponses) PostApiAdminCoinsIssuancesWithBodyWithResponse(ctx context.Context, params *PostApiAdminCoinsIssuancesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAdminCoinsIssuancesResponse, error) {
+	rsp, err := c.PostApiAdminCoinsIssuancesWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminCoinsIssuancesResponse(rsp)
+}
+
+// PostApiAdminProductsWithBodyWithResponse request with arbitrary body returning *PostApiAdminProductsResponse
+func (c *ClientWithResponses) PostApiAdminProductsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAdminProductsResponse, error) {
+	rsp, err := c.PostApiAdminProductsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminProductsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiAdminProductsWithResponse(ctx context.Context, body PostApiAdminProductsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAdminProductsResponse, error) {
+	rsp, err := c.PostApiAdminProducts(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminProductsResponse(rsp)
+}
+
+// DeleteApiAdminProductsNameWithResponse request returning *DeleteApiAdminProductsNameResponse
+func (c *ClientWithResponses) DeleteApiAdminProductsNameWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*DeleteApiAdminProductsNameResponse, error) {
+	rsp, err := c.DeleteApiAdminProductsName(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiAdminProductsNameResponse(rsp)
+}
+
+// PatchApiAdminProductsNameWithBodyWithResponse request with arbitrary body returning *PatchApiAdminProductsNameResponse
+func (c *ClientWithResponses) PatchApiAdminProductsNameWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchApiAdminProductsNameResponse, error) {
+	rsp, err := c.PatchApiAdminProductsNameWithBody(ctx, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchApiAdminProductsNameResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchApiAdminProductsNameWithResponse(ctx context.Context, name string, body PatchApiAdminProductsNameJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchApiAdminProductsNameResponse, error) {
+	rsp, err := c.PatchApiAdminProductsName(ctx, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchApiAdminProductsNameResponse(rsp)
+}
+
+// PostApiAdminTransactionsIdReverseWithBodyWithResponse request with arbitrary body returning *PostApiAdminTransactionsIdReverseResponse
+func (c *ClientWithResponses) PostApiAdminTransactionsIdReverseWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAdminTransactionsIdReverseResponse, error) {
+	rsp, err := c.PostApiAdminTransactionsIdReverseWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminTransactionsIdReverseResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiAdminTransactionsIdReverseWithResponse(ctx context.Context, id int, body PostApiAdminTransactionsIdReverseJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAdminTransactionsIdReverseResponse, error) {
+	rsp, err := c.PostApiAdminTransactionsIdReverse(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminTransactionsIdReverseResponse(rsp)
+}
+
+// GetApiAdminUsersUsernameTransferLimitsWithResponse request returning *GetApiAdminUsersUsernameTransferLimitsResponse
+func (c *ClientWithResponses) GetApiAdminUsersUsernameTransferLimitsWithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*GetApiAdminUsersUsernameTransferLimitsResponse, error) {
+	rsp, err := c.GetApiAdminUsersUsernameTransferLimits(ctx, username, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiAdminUsersUsernameTransferLimitsResponse(rsp)
+}
+
+// PutApiAdminUsersUsernameTransferLimitsWithBodyWithResponse request with arbitrary body returning *PutApiAdminUsersUsernameTransferLimitsResponse
+func (c *ClientWithResponses) PutApiAdminUsersUsernameTransferLimitsWithBodyWithResponse(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiAdminUsersUsernameTransferLimitsResponse, error) {
+	rsp, err := c.PutApiAdminUsersUsernameTransferLimitsWithBody(ctx, username, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiAdminUsersUsernameTransferLimitsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiAdminUsersUsernameTransferLimitsWithResponse(ctx context.Context, username string, body PutApiAdminUsersUsernameTransferLimitsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiAdminUsersUsernameTransferLimitsResponse, error) {
+	rsp, err := c.PutApiAdminUsersUsernameTransferLimits(ctx, username, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiAdminUsersUsernameTransferLimitsResponse(rsp)
+}
+
+// PostApiAuthWithBodyWithResponse request with arbitrary body returning *PostApiAuthResponse
+func (c *ClientWithResponses) PostApiAuthWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAuthResponse, error) {
+	rsp, err := c.PostApiAuthWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAuthResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiAuthWithResponse(ctx context.Context, body PostApiAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAuthResponse, error) {
+	rsp, err := c.PostApiAuth(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAuthResponse(rsp)
+}
+
+// GetApiBuyItemWithResponse request returning *GetApiBuyItemResponse
+func (c *ClientWithResponses) GetApiBuyItemWithResponse(ctx context.Context, item string, params *GetApiBuyItemParams, reqEditors ...RequestEditorFn) (*GetApiBuyItemResponse, error) {
+	rsp, err := c.GetApiBuyItem(ctx, item, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiBuyItemResponse(rsp)
+}
+
+// GetApiCartWithResponse request returning *GetApiCartResponse
+func (c *ClientWithResponses) GetApiCartWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiCartResponse, error) {
+	rsp, err := c.GetApiCart(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiCartResponse(rsp)
+}
+
+// PostApiCartCheckoutWithResponse request returning *PostApiCartCheckoutResponse
+func (c *ClientWithResponses) PostApiCartCheckoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostApiCartCheckoutResponse, error) {
+	rsp, err := c.PostApiCartCheckout(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiCartCheckoutResponse(rsp)
+}
+
+// PostApiCartItemsWithBodyWithResponse request with arbitrary body returning *PostApiCartItemsResponse
+func (c *ClientWithResponses) PostApiCartItemsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiCartItemsResponse, error) {
+	rsp, err := c.PostApiCartItemsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiCartItemsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiCartItemsWithResponse(ctx context.Context, body PostApiCartItemsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiCartItemsResponse, error) {
+	rsp, err := c.PostApiCartItems(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiCartItemsResponse(rsp)
+}
+
+// DeleteApiCartItemsItemWithResponse request returning *DeleteApiCartItemsItemResponse
+func (c *ClientWithResponses) DeleteApiCartItemsItemWithResponse(ctx context.Context, item string, reqEditors ...RequestEditorFn) (*DeleteApiCartItemsItemResponse, error) {
+	rsp, err := c.DeleteApiCartItemsItem(ctx, item, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiCartItemsItemResponse(rsp)
+}
+
+// GetApiInfoWithResponse request returning *GetApiInfoResponse
+func (c *ClientWithResponses) GetApiInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiInfoResponse, error) {
+	rsp, err := c.GetApiInfo(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiInfoResponse(rsp)
+}
+
+// GetApiPaymentRequestsWithResponse request returning *GetApiPaymentRequestsResponse
+func (c *ClientWithResponses) GetApiPaymentRequestsWithResponse(ctx context.Context, params *GetApiPaymentRequestsParams, reqEditors ...RequestEditorFn) (*GetApiPaymentRequestsResponse, error) {
+	rsp, err := c.GetApiPaymentRequests(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiPaymentRequestsResponse(rsp)
+}
+
+// PostApiPaymentRequestsWithBodyWithResponse request with arbitrary body returning *PostApiPaymentRequestsResponse
+func (c *ClientWithResponses) PostApiPaymentRequestsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiPaymentRequestsResponse, error) {
+	rsp, err := c.PostApiPaymentRequestsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiPaymentRequestsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiPaymentRequestsWithResponse(ctx context.Context, body PostApiPaymentRequestsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiPaymentRequestsResponse, error) {
+	rsp, err := c.PostApiPaymentRequests(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiPaymentRequestsResponse(rsp)
+}
+
+// PostApiPaymentRequestsIdApproveWithResponse request returning *PostApiPaymentRequestsIdApproveResponse
+func (c *ClientWithResponses) PostApiPaymentRequestsIdApproveWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*PostApiPaymentRequestsIdApproveResponse, error) {
+	rsp, err := c.PostApiPaymentRequestsIdApprove(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiPaymentRequestsIdApproveResponse(rsp)
+}
+
+// PostApiPaymentRequestsIdRejectWithResponse request returning *PostApiPaymentRequestsIdRejectResponse
+func (c *ClientWithResponses) PostApiPaymentRequestsIdRejectWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*PostApiPaymentRequestsIdRejectResponse, error) {
+	rsp, err := c.PostApiPaymentRequestsIdReject(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiPaymentRequestsIdRejectResponse(rsp)
+}
+
+// GetApiProductsWithResponse request returning *GetApiProductsResponse
+func (c *ClientWithResponses) GetApiProductsWithResponse(ctx context.Context, params *GetApiProductsParams, reqEditors ...RequestEditorFn) (*GetApiProductsResponse, error) {
+	rsp, err := c.GetApiProducts(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiProductsResponse(rsp)
+}
+
+// GetApiPurchasesWithResponse request returning *GetApiPurchasesResponse
+func (c *ClientWithResponses) GetApiPurchasesWithResponse(ctx context.Context, params *GetApiPurchasesParams, reqEditors ...RequestEditorFn) (*GetApiPurchasesResponse, error) {
+	rsp, err := c.GetApiPurchases(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiPurchasesResponse(rsp)
+}
+
+// PostApiPurchasesIdRefundWithResponse request returning *PostApiPurchasesIdRefundResponse
+func (c *ClientWithResponses) PostApiPurchasesIdRefundWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*PostApiPurchasesIdRefundResponse, error) {
+	rsp, err := c.PostApiPurchasesIdRefund(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiPurchasesIdRefundResponse(rsp)
+}
+
+// PostApiSendCoinWithBodyWithResponse request with arbitrary body returning *PostApiSendCoinResponse
+func (c *ClientWithResponses) PostApiSendCoinWithBodyWithResponse(ctx context.Context, params *PostApiSendCoinParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiSendCoinResponse, error) {
+	rsp, err := c.PostApiSendCoinWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiSendCoinResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiSendCoinWithResponse(ctx context.Context, params *PostApiSendCoinParams, body PostApiSendCoinJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiSendCoinResponse, error) {
+	rsp, err := c.PostApiSendCoin(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiSendCoinResponse(rsp)
+}
+
+// PostApiSendCoinBatchWithBodyWithResponse request with arbitrary body returning *PostApiSendCoinBatchResponse
+func (c *ClientWithResponses) PostApiSendCoinBatchWithBodyWithResponse(ctx context.Context, params *PostApiSendCoinBatchParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiSendCoinBatchResponse, error) {
+	rsp, err := c.PostApiSendCoinBatchWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiSendCoinBatchResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiSendCoinBatchWithResponse(ctx context.Context, params *PostApiSendCoinBatchParams, body PostApiSendCoinBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiSendCoinBatchResponse, error) {
+	rsp, err := c.PostApiSendCoinBatch(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiSendCoinBatchResponse(rsp)
+}
+
+// GetApiTransactionsWithResponse request returning *GetApiTransactionsResponse
+func (c *ClientWithResponses) GetApiTransactionsWithResponse(ctx context.Context, params *GetApiTransactionsParams, reqEditors ...RequestEditorFn) (*GetApiTransactionsResponse, error) {
+	rsp, err := c.GetApiTransactions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiTransactionsResponse(rsp)
+}
+
+// PostApiTransactionsIdAcceptWithResponse request returning *PostApiTransactionsIdAcceptResponse
+func (c *ClientWithResponses) PostApiTransactionsIdAcceptWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*PostApiTransactionsIdAcceptResponse, error) {
+	rsp, err := c.PostApiTransactionsIdAccept(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiTransactionsIdAcceptResponse(rsp)
+}
+
+// PostApiTransactionsIdDeclineWithResponse request returning *PostApiTransactionsIdDeclineResponse
+func (c *ClientWithResponses) PostApiTransactionsIdDeclineWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*PostApiTransactionsIdDeclineResponse, error) {
+	rsp, err := c.PostApiTransactionsIdDecline(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiTransactionsIdDeclineResponse(rsp)
+}
+
+// ParsePostApiAdminCoinsIssuancesResponse parses an HTTP response from a PostApiAdminCoinsIssuancesWithResponse call
+func ParsePostApiAdminCoinsIssuancesResponse(rsp *http.Response) (*PostApiAdminCoinsIssuancesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAdminCoinsIssuancesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IssuanceReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAdminProductsResponse parses an HTTP response from a PostApiAdminProductsWithResponse call
+func ParsePostApiAdminProductsResponse(rsp *http.Response) (*PostApiAdminProductsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAdminProductsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Product
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiAdminProductsNameResponse parses an HTTP response from a DeleteApiAdminProductsNameWithResponse call
+func ParseDeleteApiAdminProductsNameResponse(rsp *http.Response) (*DeleteApiAdminProductsNameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiAdminProductsNameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Product
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchApiAdminProductsNameResponse parses an HTTP response from a PatchApiAdminProductsNameWithResponse call
+func ParsePatchApiAdminProductsNameResponse(rsp *http.Response) (*PatchApiAdminProductsNameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchApiAdminProductsNameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Product
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAdminTransactionsIdReverseResponse parses an HTTP response from a PostApiAdminTransactionsIdReverseWithResponse call
+func ParsePostApiAdminTransactionsIdReverseResponse(rsp *http.Response) (*PostApiAdminTransactionsIdReverseResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAdminTransactionsIdReverseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TransferReversal
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiAdminUsersUsernameTransferLimitsResponse parses an HTTP response from a GetApiAdminUsersUsernameTransferLimitsWithResponse call
+func ParseGetApiAdminUsersUsernameTransferLimitsResponse(rsp *http.Response) (*GetApiAdminUsersUsernameTransferLimitsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiAdminUsersUsernameTransferLimitsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TransferLimits
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutApiAdminUsersUsernameTransferLimitsResponse parses an HTTP response from a PutApiAdminUsersUsernameTransferLimitsWithResponse call
+func ParsePutApiAdminUsersUsernameTransferLimitsResponse(rsp *http.Response) (*PutApiAdminUsersUsernameTransferLimitsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiAdminUsersUsernameTransferLimitsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TransferLimits
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAuthResponse parses an HTTP response from a PostApiAuthWithResponse call
+func ParsePostApiAuthResponse(rsp *http.Response) (*PostApiAuthResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAuthResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiBuyItemResponse parses an HTTP response from a GetApiBuyItemWithResponse call
+func ParseGetApiBuyItemResponse(rsp *http.Response) (*GetApiBuyItemResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiBuyItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiCartResponse parses an HTTP response from a GetApiCartWithResponse call
+func ParseGetApiCartResponse(rsp *http.Response) (*GetApiCartResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiCartResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CartResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiCartCheckoutResponse parses an HTTP response from a PostApiCartCheckoutWithResponse call
+func ParsePostApiCartCheckoutResponse(rsp *http.Response) (*PostApiCartCheckoutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiCartCheckoutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiCartItemsResponse parses an HTTP response from a PostApiCartItemsWithResponse call
+func ParsePostApiCartItemsResponse(rsp *http.Response) (*PostApiCartItemsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiCartItemsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiCartItemsItemResponse parses an HTTP response from a DeleteApiCartItemsItemWithResponse call
+func ParseDeleteApiCartItemsItemResponse(rsp *http.Response) (*DeleteApiCartItemsItemResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiCartItemsItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiInfoResponse parses an HTTP response from a GetApiInfoWithResponse call
+func ParseGetApiInfoResponse(rsp *http.Response) (*GetApiInfoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiInfoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InfoResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiPaymentRequestsResponse parses an HTTP response from a GetApiPaymentRequestsWithResponse call
+func ParseGetApiPaymentRequestsResponse(rsp *http.Response) (*GetApiPaymentRequestsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiPaymentRequestsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PaymentRequestsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiPaymentRequestsResponse parses an HTTP response from a PostApiPaymentRequestsWithResponse call
+func ParsePostApiPaymentRequestsResponse(rsp *http.Response) (*PostApiPaymentRequestsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiPaymentRequestsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiPaymentRequestsIdApproveResponse parses an HTTP response from a PostApiPaymentRequestsIdApproveWithResponse call
+func ParsePostApiPaymentRequestsIdApproveResponse(rsp *http.Response) (*PostApiPaymentRequestsIdApproveResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiPaymentRequestsIdApproveResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiPaymentRequestsIdRejectResponse parses an HTTP response from a PostApiPaymentRequestsIdRejectWithResponse call
+func ParsePostApiPaymentRequestsIdRejectResponse(rsp *http.Response) (*PostApiPaymentRequestsIdRejectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiPaymentRequestsIdRejectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiProductsResponse parses an HTTP response from a GetApiProductsWithResponse call
+func ParseGetApiProductsResponse(rsp *http.Response) (*GetApiProductsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiProductsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProductsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiPurchasesResponse parses an HTTP response from a GetApiPurchasesWithResponse call
+func ParseGetApiPurchasesResponse(rsp *http.Response) (*GetApiPurchasesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiPurchasesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PurchasesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiPurchasesIdRefundResponse parses an HTTP response from a PostApiPurchasesIdRefundWithResponse call
+func ParsePostApiPurchasesIdRefundResponse(rsp *http.Response) (*PostApiPurchasesIdRefundResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiPurchasesIdRefundResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiSendCoinResponse parses an HTTP response from a PostApiSendCoinWithResponse call
+func ParsePostApiSendCoinResponse(rsp *http.Response) (*PostApiSendCoinResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiSendCoinResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiSendCoinBatchResponse parses an HTTP response from a PostApiSendCoinBatchWithResponse call
+func ParsePostApiSendCoinBatchResponse(rsp *http.Response) (*PostApiSendCoinBatchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiSendCoinBatchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BatchSendCoinResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiTransactionsResponse parses an HTTP response from a GetApiTransactionsWithResponse call
+func ParseGetApiTransactionsResponse(rsp *http.Response) (*GetApiTransactionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiTransactionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TransactionsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiTransactionsIdAcceptResponse parses an HTTP response from a PostApiTransactionsIdAcceptWithResponse call
+func ParsePostApiTransactionsIdAcceptResponse(rsp *http.Response) (*PostApiTransactionsIdAcceptResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiTransactionsIdAcceptResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiTransactionsIdDeclineResponse parses an HTTP response from a PostApiTransactionsIdDeclineWithResponse call
+func ParsePostApiTransactionsIdDeclineResponse(rsp *http.Response) (*PostApiTransactionsIdDeclineResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiTransactionsIdDeclineResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Начислить монеты пользователям из CSV-файла (только для администраторов).
+	// (POST /api/admin/coins/issuances)
+	PostApiAdminCoinsIssuances(c *gin.Context, params PostApiAdminCoinsIssuancesParams)
+	// Создать товар (только для администраторов).
+	// (POST /api/admin/products)
+	PostApiAdminProducts(c *gin.Context)
+	// Снять товар с продажи (только для администраторов). История покупок сохраняется.
+	// (DELETE /api/admin/products/{name})
+	DeleteApiAdminProductsName(c *gin.Context, name string)
+	// Изменить название, цену или доступность товара (только для администраторов).
+	// (PATCH /api/admin/products/{name})
+	PatchApiAdminProductsName(c *gin.Context, name string)
+	// Отменить выполненный перевод (только для администраторов).
+	// (POST /api/admin/transactions/{id}/reverse)
+	PostApiAdminTransactionsIdReverse(c *gin.Context, id int)
+	// Получить действующие лимиты переводов пользователя (только для администраторов).
+	// (GET /api/admin/users/{username}/transferLimits)
+	GetApiAdminUsersUsernameTransferLimits(c *gin.Context, username string)
+	// Задать лимиты переводов пользователя (только для администраторов). Незаданные лимиты берутся из конфигурации.
+	// (PUT /api/admin/users/{username}/transferLimits)
+	PutApiAdminUsersUsernameTransferLimits(c *gin.Context, username string)
+	// Аутентификация и получение JWT-токена. При первой аутентификации пользователь создается автоматически.
+	// (POST /api/auth)
+	PostApiAuth(c *gin.Context)
+	// Купить предмет за монеты.
+	// (GET /api/buy/{item})
+	GetApiBuyItem(c *gin.Context, item string, params GetApiBuyItemParams)
+	// Получить содержимое корзины и её итоговую стоимость.
+	// (GET /api/cart)
+	GetApiCart(c *gin.Context)
+	// Оформить покупку всех товаров из корзины одной транзакцией.
+	// (POST /api/cart/checkout)
+	PostApiCartCheckout(c *gin.Context)
+	// Добавить товар в корзину.
+	// (POST /api/cart/items)
+	PostApiCartItems(c *gin.Context)
+	// Удалить товар из корзины.
+	// (DELETE /api/cart/items/{item})
+	DeleteApiCartItemsItem(c *gin.Context, item string)
+	// Получить информацию о монетах, инвентаре и истории транзакций.
+	// (GET /api/info)
+	GetApiInfo(c *gin.Context)
+	// Получить входящие запросы монет, начиная с последних.
+	// (GET /api/paymentRequests)
+	GetApiPaymentRequests(c *gin.Context, params GetApiPaymentRequestsParams)
+	// Запросить монеты у другого пользователя.
+	// (POST /api/paymentRequests)
+	PostApiPaymentRequests(c *gin.Context)
+	// Одобрить запрос монет и перевести монеты автору запроса.
+	// (POST /api/paymentRequests/{id}/approve)
+	PostApiPaymentRequestsIdApprove(c *gin.Context, id int)
+	// Отклонить запрос монет.
+	// (POST /api/paymentRequests/{id}/reject)
+	PostApiPaymentRequestsIdReject(c *gin.Context, id int)
+	// Получить каталог товаров.
+	// (GET /api/products)
+	GetApiProducts(c *gin.Context, params GetApiProductsParams)
+	// Получить историю покупок пользователя, начиная с последних.
+	// (GET /api/purchases)
+	GetApiPurchases(c *gin.Context, params GetApiPurchasesParams)
+	// Вернуть покупку в течение срока возврата.
+	// (POST /api/purchases/{id}/refund)
+	PostApiPurchasesIdRefund(c *gin.Context, id int)
+	// Отправить монеты другому пользователю.
+	// (POST /api/sendCoin)
+	PostApiSendCoin(c *gin.Context, params PostApiSendCoinParams)
+	// Отправить монеты нескольким пользователям одной операцией.
+	// (POST /api/sendCoin/batch)
+	PostApiSendCoinBatch(c *gin.Context, params PostApiSendCoinBatchParams)
+	// Получить историю переводов пользователя, начиная с последних.
+	// (GET /api/transactions)
+	GetApiTransactions(c *gin.Context, params GetApiTransactionsParams)
+	// Принять перевод, ожидающий подтверждения.
+	// (POST /api/transactions/{id}/accept)
+	PostApiTransactionsIdAccept(c *gin.Context, id int)
+	// Отклонить перевод, ожидающий подтверждения, и вернуть монеты отправителю.
+	// (POST /api/transactions/{id}/decline)
+	PostApiTransactionsIdDecline(c *gin.Context, id int)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandler       func(*gin.Context, error, int)
+}
+
+type MiddlewareFunc func(c *gin.Context)
+
+// PostApiAdminCoinsIssuances operation middleware
+func (siw *ServerInterfaceWrapper) PostApiAdminCoinsIssuances(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{"admin"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostApiAdminCoinsIssuancesParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dryRun: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiAdminCoinsIssuances(c, params)
+}
+
+// PostApiAdminProducts operation middleware
+func (siw *ServerInterfaceWrapper) PostApiAdminProducts(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{"admin"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiAdminProducts(c)
+}
+
+// DeleteApiAdminProductsName operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiAdminProductsName(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{"admin"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteApiAdminProductsName(c, name)
+}
+
+// PatchApiAdminProductsName operation middleware
+func (siw *ServerInterfaceWrapper) PatchApiAdminProductsName(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{"admin"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PatchApiAdminProductsName(c, name)
+}
+
+// PostApiAdminTransactionsIdReverse operation middleware
+func (siw *ServerInterfaceWrapper) PostApiAdminTransactionsIdReverse(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{"admin"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiAdminTransactionsIdReverse(c, id)
+}
+
+// GetApiAdminUsersUsernameTransferLimits operation middleware
+func (siw *ServerInterfaceWrapper) GetApiAdminUsersUsernameTransferLimits(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "username" -------------
+	var username string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "username", c.Param("username"), &username, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{"admin"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiAdminUsersUsernameTransferLimits(c, username)
+}
+
+// PutApiAdminUsersUsernameTransferLimits operation middleware
+func (siw *ServerInterfaceWrapper) PutApiAdminUsersUsernameTransferLimits(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "username" -------------
+	var username string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "username", c.Param("username"), &username, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{"admin"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutApiAdminUsersUsernameTransferLimits(c, username)
+}
+
+// PostApiAuth operation middleware
+func (siw *ServerInterfaceWrapper) PostApiAuth(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiAuth(c)
+}
+
+// GetApiBuyItem operation middleware
+func (siw *ServerInterfaceWrapper) GetApiBuyItem(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "item" -------------
+	var item string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "item", c.Param("item"), &item, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter item: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiBuyItemParams
+
+	// ------------- Optional query parameter "quantity" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "quantity", c.Request.URL.Query(), &params.Quantity)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter quantity: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiBuyItem(c, item, params)
+}
+
+// GetApiCart operation middleware
+func (siw *ServerInterfaceWrapper) GetApiCart(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiCart(c)
+}
+
+// PostApiCartCheckout operation middleware
+func (siw *ServerInterfaceWrapper) PostApiCartCheckout(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiCartCheckout(c)
+}
+
+// PostApiCartItems operation middleware
+func (siw *ServerInterfaceWrapper) PostApiCartItems(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiCartItems(c)
+}
+
+// DeleteApiCartItemsItem operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiCartItemsItem(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "item" -------------
+	var item string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "item", c.Param("item"), &item, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter item: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteApiCartItemsItem(c, item)
+}
+
+// GetApiInfo operation middleware
+func (siw *ServerInterfaceWrapper) GetApiInfo(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiInfo(c)
+}
+
+// GetApiPaymentRequests operation middleware
+func (siw *ServerInterfaceWrapper) GetApiPaymentRequests(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiPaymentRequestsParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiPaymentRequests(c, params)
+}
+
+// PostApiPaymentRequests operation middleware
+func (siw *ServerInterfaceWrapper) PostApiPaymentRequests(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiPaymentRequests(c)
+}
+
+// PostApiPaymentRequestsIdApprove operation middleware
+func (siw *ServerInterfaceWrapper) PostApiPaymentRequestsIdApprove(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiPaymentRequestsIdApprove(c, id)
+}
+
+// PostApiPaymentRequestsIdReject operation middleware
+func (siw *ServerInterfaceWrapper) PostApiPaymentRequestsIdReject(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
 		}
-		response.JSON401 = &dest
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	siw.Handler.PostApiPaymentRequestsIdReject(c, id)
+}
+
+// GetApiProducts operation middleware
+func (siw *ServerInterfaceWrapper) GetApiProducts(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiProductsParams
 
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	return response, nil
-}
+	// ------------- Optional query parameter "order" -------------
 
-// ParseGetApiBuyItemResponse parses an HTTP response from a GetApiBuyItemWithResponse call
-func ParseGetApiBuyItemResponse(rsp *http.Response) (*GetApiBuyItemResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindQueryParameter("form", true, false, "order", c.Request.URL.Query(), &params.Order)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter order: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	response := &GetApiBuyItemResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	// ------------- Optional query parameter "offset" -------------
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
 		}
-		response.JSON500 = &dest
-
 	}
 
-	return response, nil
+	siw.Handler.GetApiProducts(c, params)
 }
 
-// ParseGetApiInfoResponse parses an HTTP response from a GetApiInfoWithResponse call
-func ParseGetApiInfoResponse(rsp *http.Response) (*GetApiInfoResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// GetApiPurchases operation middleware
+func (siw *ServerInterfaceWrapper) GetApiPurchases(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiPurchasesParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	response := &GetApiInfoResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// ------------- Optional query parameter "offset" -------------
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest InfoResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
 		}
-		response.JSON400 = &dest
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	siw.Handler.GetApiPurchases(c, params)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// PostApiPurchasesIdRefund operation middleware
+func (siw *ServerInterfaceWrapper) PostApiPurchasesIdRefund(c *gin.Context) {
 
-	}
+	var err error
 
-	return response, nil
-}
+	// ------------- Path parameter "id" -------------
+	var id int
 
-// ParsePostApiSendCoinResponse parses an HTTP response from a PostApiSendCoinWithResponse call
-func ParsePostApiSendCoinResponse(rsp *http.Response) (*PostApiSendCoinResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	response := &PostApiSendCoinResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	c.Set(BearerAuthScopes, []string{})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
 		}
-		response.JSON400 = &dest
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	siw.Handler.PostApiPurchasesIdRefund(c, id)
+}
+
+// PostApiSendCoin operation middleware
+func (siw *ServerInterfaceWrapper) PostApiSendCoin(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostApiSendCoinParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
 		}
-		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
 		}
-		response.JSON500 = &dest
 
-	}
+		params.IdempotencyKey = &IdempotencyKey
 
-	return response, nil
-}
+	}
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Аутентификация и получение JWT-токена. При первой аутентификации пользователь создается автоматически.
-	// (POST /api/auth)
-	PostApiAuth(c *gin.Context)
-	// Купить предмет за монеты.
-	// (GET /api/buy/{item})
-	GetApiBuyItem(c *gin.Context, item string)
-	// Получить информацию о монетах, инвентаре и истории транзакций.
-	// (GET /api/info)
-	GetApiInfo(c *gin.Context)
-	// Отправить монеты другому пользователю.
-	// (POST /api/sendCoin)
-	PostApiSendCoin(c *gin.Context)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandler       func(*gin.Context, error, int)
+	siw.Handler.PostApiSendCoin(c, params)
 }
 
-type MiddlewareFunc func(c *gin.Context)
+// PostApiSendCoinBatch operation middleware
+func (siw *ServerInterfaceWrapper) PostApiSendCoinBatch(c *gin.Context) {
 
-// PostApiAuth operation middleware
-func (siw *ServerInterfaceWrapper) PostApiAuth(c *gin.Context) {
+	var err error
 
 	c.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostApiSendCoinBatchParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -815,24 +5814,66 @@ func (siw *ServerInterfaceWrapper) PostApiAuth(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostApiAuth(c)
+	siw.Handler.PostApiSendCoinBatch(c, params)
 }
 
-// GetApiBuyItem operation middleware
-func (siw *ServerInterfaceWrapper) GetApiBuyItem(c *gin.Context) {
+// GetApiTransactions operation middleware
+func (siw *ServerInterfaceWrapper) GetApiTransactions(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "item" -------------
-	var item string
+	c.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "item", c.Param("item"), &item, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiTransactionsParams
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter item: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	c.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "counterparty" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "counterparty", c.Request.URL.Query(), &params.Counterparty)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter counterparty: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -841,11 +5882,22 @@ func (siw *ServerInterfaceWrapper) GetApiBuyItem(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetApiBuyItem(c, item)
+	siw.Handler.GetApiTransactions(c, params)
 }
 
-// GetApiInfo operation middleware
-func (siw *ServerInterfaceWrapper) GetApiInfo(c *gin.Context) {
+// PostApiTransactionsIdAccept operation middleware
+func (siw *ServerInterfaceWrapper) PostApiTransactionsIdAccept(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	c.Set(BearerAuthScopes, []string{})
 
@@ -856,11 +5908,22 @@ func (siw *ServerInterfaceWrapper) GetApiInfo(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetApiInfo(c)
+	siw.Handler.PostApiTransactionsIdAccept(c, id)
 }
 
-// PostApiSendCoin operation middleware
-func (siw *ServerInterfaceWrapper) PostApiSendCoin(c *gin.Context) {
+// PostApiTransactionsIdDecline operation middleware
+func (siw *ServerInterfaceWrapper) PostApiTransactionsIdDecline(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	c.Set(BearerAuthScopes, []string{})
 
@@ -871,7 +5934,7 @@ func (siw *ServerInterfaceWrapper) PostApiSendCoin(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostApiSendCoin(c)
+	siw.Handler.PostApiTransactionsIdDecline(c, id)
 }
 
 // GinServerOptions provides options for the Gin server.
@@ -901,36 +5964,141 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 		ErrorHandler:       errorHandler,
 	}
 
+	router.POST(options.BaseURL+"/api/admin/coins/issuances", wrapper.PostApiAdminCoinsIssuances)
+	router.POST(options.BaseURL+"/api/admin/products", wrapper.PostApiAdminProducts)
+	router.DELETE(options.BaseURL+"/api/admin/products/:name", wrapper.DeleteApiAdminProductsName)
+	router.PATCH(options.BaseURL+"/api/admin/products/:name", wrapper.PatchApiAdminProductsName)
+	router.POST(options.BaseURL+"/api/admin/transactions/:id/reverse", wrapper.PostApiAdminTransactionsIdReverse)
+	router.GET(options.BaseURL+"/api/admin/users/:username/transferLimits", wrapper.GetApiAdminUsersUsernameTransferLimits)
+	router.PUT(options.BaseURL+"/api/admin/users/:username/transferLimits", wrapper.PutApiAdminUsersUsernameTransferLimits)
 	router.POST(options.BaseURL+"/api/auth", wrapper.PostApiAuth)
 	router.GET(options.BaseURL+"/api/buy/:item", wrapper.GetApiBuyItem)
+	router.GET(options.BaseURL+"/api/cart", wrapper.GetApiCart)
+	router.POST(options.BaseURL+"/api/cart/checkout", wrapper.PostApiCartCheckout)
+	router.POST(options.BaseURL+"/api/cart/items", wrapper.PostApiCartItems)
+	router.DELETE(options.BaseURL+"/api/cart/items/:item", wrapper.DeleteApiCartItemsItem)
 	router.GET(options.BaseURL+"/api/info", wrapper.GetApiInfo)
+	router.GET(options.BaseURL+"/api/paymentRequests", wrapper.GetApiPaymentRequests)
+	router.POST(options.BaseURL+"/api/paymentRequests", wrapper.PostApiPaymentRequests)
+	router.POST(options.BaseURL+"/api/paymentRequests/:id/approve", wrapper.PostApiPaymentRequestsIdApprove)
+	router.POST(options.BaseURL+"/api/paymentRequests/:id/reject", wrapper.PostApiPaymentRequestsIdReject)
+	router.GET(options.BaseURL+"/api/products", wrapper.GetApiProducts)
+	router.GET(options.BaseURL+"/api/purchases", wrapper.GetApiPurchases)
+	router.POST(options.BaseURL+"/api/purchases/:id/refund", wrapper.PostApiPurchasesIdRefund)
 	router.POST(options.BaseURL+"/api/sendCoin", wrapper.PostApiSendCoin)
+	router.POST(options.BaseURL+"/api/sendCoin/batch", wrapper.PostApiSendCoinBatch)
+	router.GET(options.BaseURL+"/api/transactions", wrapper.GetApiTransactions)
+	router.POST(options.BaseURL+"/api/transactions/:id/accept", wrapper.PostApiTransactionsIdAccept)
+	router.POST(options.BaseURL+"/api/transactions/:id/decline", wrapper.PostApiTransactionsIdDecline)
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xXXWsbRxT9K8u0Dy1sJaUfEPTm9NOBQmhS8hD8sJHG0Sbenc3MrIowAn0kbYJDXPpU",
-	"Aq1p+wfWqlSvZWn1F+78o3LvrqxvWyZ2oNRv2tnR3jNnzp1zZpeVhBcIn/taseIuU6UK9xz6uRHqynf8",
-	"aciVxsdAioBL7XJ6GThK/SBkGX+XuSpJN9Cu8FmRwQFEpgEJnJhXFnThxOxbEJm2aUEPhqYFsXkGMfQh",
-	"Mj9CDHGO2WxbSM/RrDj5rM10LeCsyJSWrv+I1W0WKi59x+NLSv4KA6wySqvCESTQgYgqUvn1UMxVrNtM",
-	"8qehK3mZFR9MytsTlFunfxIPH/OSRpgpbSoQvuKLvGnxhPuLK7h9/95HpgUJ9BHeKeAuJKZpWqYNI4gs",
-	"6FtwBJF5CbF5ifNgaPZgYJkG9EzTtE3DNCGCwfK1LAD9UkohVyPl+FotIfsPSCCBwwxCDD0LHy1IzAuI",
-	"4RCXYOPQCGLTNHu0E69pds+CEUnjEE6gBwPTXhPqpr8tViMtCdf/xlVayNriS8lL3K1yEqqruacWpzie",
-	"CH29ZKVvUE8Qm5+I3xZ0IBmLrI2D6Q6Y5xYMIIEh9ExrakGur/kjLhH/thTe94rLC0vXtqAPCSrDNMwe",
-	"HFv0gCRG0IEYTqZKm7012cwGHCmdGj4rnq7+UuiZxndyAYq0eGuCIEFJLYFg9t6epmUzUHhqXWKme3k9",
-	"Sly/yv2xqldsztPQ8bWra2urFw8L6MIAyyKVK3aDRhY++SfEMJr/SHRpfN7lfvlz4forXediUjyld66L",
-	"ehYO0xn2HBLoQoxT51rLtMyrK1fq0LThHxguLb6GZKftKUNljzlatCbq9FIoXV27iy6fUnqLO5JLNC18",
-	"ekhPX43t+Pb9e8xOMwF+KX07gVLROmD1Okl1W5C9uXoH32zc2bQ2qq4WlqqI4INvnSfK9UTV+oL7rvqQ",
-	"2azKpUppu5Er5ArIqwi47wQuK7JPaAh9VlcIZN4J3LyTYQxEKg0UhoPcb5ZZkd0RSm8ELi0kZYYrfUuU",
-	"a6lD+Do75Jwg2HFL9L/8YyX8SejBX+9Lvs2K7L38JBXls0iUn85D9Vn6tQw5DaQWRZg/LhQuuXTmf1R7",
-	"Tnp/mSaMoGdewBCiM6OO2c8h2Z9eIrrZILEM3m/Qgw70TIMSyzHFmDQKmGYG58Y7hhNBJ+vFeNypMCQs",
-	"n71Tan7BQ8C06ERFr9zH3ZtEqcgyTSIupS/KpX0cep6DvsDg59UbbUE8H1cwrc1mTYhyFhwgDRYpCCsl",
-	"uEVnhOVVZ9wrBJvAEXQhonOrSVJMmYYBzcvOaOhj5sa1UG8/DGv5XbS4OjL6iC9p8K859vetsLapuUeH",
-	"g3Q8rjlm1Ae7zPXp+kDdn94SyDLZfJvaUzs3f6JuLW/h1b02iWSd1MavO+u/3lmZQ5Kmpr3xwRbKY6rx",
-	"3lCSy6x6JhHRJszZ96nSx055hsbxtsOu0E1mblPnuMm1wv+/Cj84tY5M5TEMzTNa9yDzmNfWTNCGyDy3",
-	"aR7WJOuIEB05UUzJPGUttgh2BEPazj75yvFUm6jsKnBu4BvfGa4o9M1fSdYPftc9dd1Tiz31+5nXPAu6",
-	"pmHa8Pf4grg8Z73OpdDPK8tldRyPQrmTXdiK+fyOKDk7FaF08WbhZoHVt+r/BgAA///LjqGABxYAAA==",
+	"H4sIAAAAAAAC/+xd3XIbR3Z+lalJLqwURFL+qWy4V5K8SbhxEpVk7144LNcIaIqzBmbgmYEslopVJGFZ",
+	"VlER1y6n7HLWdmk3ldxCFGGCFAC+Qvcr5Em2zumf6Znp+QEJ0pI8VboQgUHP6dOnv/Pffd9u+p2u7xEv",
+	"Cu3l+3bYXCcdB/97tRet3ySf9EgYwZ/dwO+SIHIJftl1wvBTP2jB/1skbAZuN3J9z1626Y90wLbolL5g",
+	"jy16QF+wPYsOWJ/t0CGdsB06Yp/RET2mA/Y5HdHRgt2w1/yg40T2cjxsw442usRetsMocL079mbD7oUk",
+	"8JwOMbzyWzqGt5zwt9JDOqX7dIBvxNdXoyL1xs2GHZBPem5AWvbyh/HrGzGVq+pH/u0/kGYEZHK2hV3f",
+	"C0mWb5H/MfGyM/jt79+/zHbolB4DeYrgAzpl22yH9ekJHVj02KKHdMAe0RF7BM/RCdulY4tt0SHbZn22",
+	"xbbpgI7Nc8kQes2Jmus3SdPtusQzLLHT8XtelKWVfgdspiP2EF+7Q/fp1KJjOqUTOmQ7DYse0ylMhm2x",
+	"XTq04GM6pc/YAzqlB3QEj1r4xAnbogO6T0dshz3WqHa9iNwhARAZ+R+EJJh5yXUi6JSOWd+iE9anP9GJ",
+	"8eUa/Wy3XBYEVQ3Jo9U89t4iXuu673q5+6hDwtC5Y5Lpp3SKXOMrPQJGHsN8h7DcwHN6wPoNSz0zVFKz",
+	"z7bpkD2QzOmzh5IxdEiPYHYd5957xLsTrdvLb77zjmGvBVIsQuMGTw87sujIAhGkYzpmuyky6QDe6Uak",
+	"g6P9bUDW7GX7bxZj5FkUsLOYkslNJHWF//LK0lLD7rie/FOR7QSBs5FZI20KFZYnb7/OtD5T4P0uZztK",
+	"/QTEjY7T/BgumPAtChwvdJow/korNIr8QQa8xDaz2Dad0kPgNYcF9iD90indt+DfCf5ijx4A1hTIiFov",
+	"w6Ys4rvkWGZCplW47gQRrKdBwQRu08T2/0W2DiycE3D3yKJDhJUJHbHPgRU7Agu2uOBl6f+k53iRG21U",
+	"xbYTZOMBHdMhHzwPqyKnbZIU/BHiHtvmaHOCqzXiusd6g30uZvV3iFtpCi7lvA8/ybzuz3RET9JEDyoq",
+	"BbkguYDlitVKvfR7OqCHwHWxF3IWIZb2WddAW+PM4B3Xczu9jo4Jik0p6UTytbfnCWU+Iqh9UQnQlIBn",
+	"tk2+vHyL83su5rhnse2sAB2jZjsEliQUlj7vzMR+EwR+kD+zpt8yydOf6IB9gbyfsoegLumADgHm6RGX",
+	"1gNQqPDEM3pMRw1U+GipoHbdZntgscDDU7ZjsT16yHYBu/DBAcrpCKV0y3K9sLe25jYBtj9q+q4XWnSE",
+	"+gWxZI0EH7Uct73xUdvtuJFRrlokcty2CT5/iGlEUhBNwXZQcDnl6NewQPDwtfRQUDhl23xeCLRTlEow",
+	"FlA+WR/+D+v0ADhDh9bVGyuVdd7vnLbbcoBKXJ93cQImeSHwdUVNRJ/pi4JG2CDm/RDYeshn9xynDbJ2",
+	"TAfW1WaTdKPL7znenZ5zh1hvBD25BMRrIJMsVPOgNR7y7c6eWEHvUkWA+c29rgvfgtoN52Z0mgGSwLtI",
+	"eNU03lcIj2NpKtLnoDsTZiAs9XOuLNlOwktpORG5HLkdEr81x1AU09FJMSHOirfmF+1L1/tnN4z8YCP7",
+	"ZUCaxL1LWglEOgtPY4NgGJsSJYxeC/zOme10DigJ05y+KDPMG9LeCMs3BtvLmtDgLwlg+k8Ju7nUmsyi",
+	"mJCMVZRZ5vSGDoXfNZd10xn3Yoa1CyMn6pWC1C3iRbhlb/HH5+uaZWhnuxrdeR5ZCX9NTzQl6lThqO59",
+	"V+Ol2OW3fN8zKlI1oYyTrGMNmunPgB76Ex3QI4TxofXWkoUmb0pDjdgDrpnQst8BLrKd2KWupIKSoGyQ",
+	"VNe7SzwJQDniel5W9TlYueWCshKGPcdrkpuk6wem4Ei323ZJq3iVUeuizbQtxVoj8Lbvt4njodESbNzs",
+	"mUTmG938SPmWICY4+5+4/cSZMUXDZAusHPO70IqougfgPzjoscW2dYtiQMd0ZF6wwP+0un2s+Ox/OouJ",
+	"XEIrcOYz2DrA9hzrWFfTgv8NtazyzYpdYlqrRaLif3re9kyOARhbtgOcumIEHWpIoX08kIgBf7Mv6As6",
+	"EH9I6WF9o7JFCq7PxU9IU2p8X9v1iNHbnAqPQRthVL7ssOpOaATnH8ENAbp5dCG1ccF4WDhLUFoLsBhH",
+	"Sgkkzruhx5yVJSlmYJLEG85Gh3hRru+eK4wSZvgC7vOFo1OMOZ5CTpsBcSLSKra7kyErNM40Z4sOqlrc",
+	"c7U+dQoqWZ9ua5ZAnWGKpxfRvKFi4mLTzhCRGoBlw7bT41hJnaNJr/Rx458G5C4JQtLSzZEBKmMeqdlW",
+	"5gjOHTg9Fj7qi5QdnloKsMlBwz1ju/DolJ4ARnGfBKZKPAj3fGh3ideCySJyB/5dhO6AwIYQ/+UU2qsZ",
+	"9myWbqDrKMY/+246g3izvsZW6dwcpsljTzCQsVcu7acTTYt/bYkw9DO2JYRgJMQJ47g8E7MtgSER0KDj",
+	"rOfG+hUyGSlYVawszNwkpSCcVygwOWw1M/RG4Ld6TZPw3XXctnO7bVI8X2t+C9qJqP1VzFTliUBsjvGp",
+	"XGMxR7XNHO0ti+brA6D7oySRDtiDPMfVb35stIYERnGzNTEyvmubHgOaYCLGoj+g1PWTsTwd0Yx7dsKj",
+	"bM/ZFmeCCJdMq4ZixcKWQcy8V9l6Iy+CFwU9cullFwIV6F86R4H4L7nwwqkeChdzQCeNU0hDEc0peJLl",
+	"Bcin1Xyp+aDbulCpmVEspgjQPOY8Hwn5Ps6FfH7OwoKvkqHIGeSm9IU9D5MWpHUr581P6YTt8exOVpRG",
+	"MoZvFMAcxqrFKkCg+ak3Pt4sXvwPcelCyaxk8ppb0gNMWDzPde2zc+0FzXXHOMcZ7fe8faEt9NzgUhBd",
+	"5kdlaarmNs0ar+OvSISWi5KyRZHmInckMxtp64PMtYm069d6Xsto1wtxu5EDJU95kQqkH1lfdyvQ5edO",
+	"aIII1jfPpue50Y0SjVZQmSB285hHMkDYKghXkWzPbyOLAY0Ziy7xomKuamHoBF9FWIVb74d0H594xL7k",
+	"0kSHFaZ/aiTRhp5yRyI3v1OF5aVVXS9F6dwZS8sq1omh9cKzxhAENZaLaeMmyvBO8E07IuZ4IEvxshGz",
+	"JwsWWC7pX/zEcZoHjxKZ2z49EE8kPNxfa2Z99i2POWuPUbvAqDsppshMuEq3pKmHDY8+NoY2MSzZSFIm",
+	"np4Ih2Mvs5pivkaL69UthkynD40yyetc2J6UyUz92oIl4j3W/299nfoeeDvF9T7g610oLKaqt3GjmhDl",
+	"6SRBm1ElvR+Xws2AFgpVjQWVhrgrjEOCrhNEG/lSsp8IBwkmAOsn3NQpelk8o2oxXtNQ1ayTlhsQxS+D",
+	"KZVMdRvkZcAle8JjuXKLo8jjH8esjwBYlvCXS43J+kZcb2Fa5qKCk6cyN1UolkluWfTPgrBjOtV8s3Rd",
+	"J9vOHRYWojrXZzeHK4jlHBSRQfFAYNdp//vabASrGDQ3POKCj9MxXuSwpPVKh5Yk7GwmcJqalA7NvFXi",
+	"YgyBGK2fGQJ5PEznEHeFc6P6hvLm6kmDgZUwBnc0/MVBuP2D+gojyvjyXR5Rl+pzX9AzZA9FO0RmmcZF",
+	"y/QfXgmcQ0lhE/JxLVXGlcgsxLJYMcmg6YK52ezamCaz3SP3ouu9IDRmj78TnSNTTKeiHBywPnuCu/JI",
+	"ZlgH0ovJC5kKC/+ETuUgdGIYwJjozWXTGgneg6hJaAxlDemRpADJRQB5gWbyiFtbBhMCIzzc2KOHIs87",
+	"4NY3ewhCrQ2RF9lbsBup5cKyVCTVnBmnx5hRHNOB0EKisDdHwSO2HGb5OcJSIWC99JGKg07rfi9ob1zP",
+	"sS9MdE0LfKhqFB5Z3KIop67j3LvamYG2NM9kC4ARwkvf/ikhH1/Umv29Kt2qEBIu2QqZ6G+K8v8u3AD5",
+	"tg7sjCFMIO4ioUeJzfAMBmNfSujFEmIQlQlq2eeAI7KfDou7+T5j23Qi2IgwYQxu1juq3lE/w466KVV3",
+	"tui6mn8T25O7MxSs+EHTWDuobC86yJT7YcLBwp+aowOz2u4p0k9fiGIeKGurr8xIYMK0KxBKE+XcOLtm",
+	"coP/mGfLNpIlKvtYH3GUemV56965OCTlNYxuK91yZydYn+CKWlwljLpHv1phx+TGPnG8QtlWYb5k/Q9o",
+	"nZ/oUAvRPcNMz4BOUpVIqrFblY9wxYKLCTbmIHb3wSUw75dTiLcZE1NB0o7ryb+vlMXOCor5zG1BWXa7",
+	"pN0qCglitoHXRzPMO4gY5gnri1YyydsnPDDyIlPBY7GHYtqHFsrtw9wCUdfE0P/jASb2ODVuQ+T04tjk",
+	"EU8aHwlHdZAoOdajMbf91gZ24kfr2M5HAvhznTgtEhhDM/lBiB+4Y6kSc9orKxwL4Hp2PHh2HbHLo9kL",
+	"3GjjFvhqfNGuEScgAZwUAH/dxr/+UaqO3/7+fbvBD2JAicVvYzrWo6hrb24ir9d8+H3kRpDrt6/eWLGu",
+	"3nUj3wrX/e4b/+p8HLod/671LvHc8JLdsGHn8klfWVhaWAK2+F3iOV3XXrbfwo84S5HIRafrLjqtjust",
+	"YtvEoisKnfHbrm80Pv+Hl9/yGi4VPhUxAFWoK+taGzz02eDb4NepEuVsd5rKh+zBl/EmxxjJU+XMpcuZ",
+	"2Z4WL0AD7LEqFG5YaqixaJ03Vv/GA0JEZU8NpzciKz/3EE0dfrBEXFACWRy2A796xnbl7wbJSWf4Fksj",
+	"dvtLo/m5KjvRaI3nycMagBOOVEr2DT+Mrnbdq7CiGItfUesJqx44HRKRILSXPzQ0W2jBMI2xAso1XmZJ",
+	"MtQVAkioPcvT5XEJPg9paB1WcRXDKt96JIyuwfbH1jgvEhnJTq8duRD6XgQj7HLLiZz4QBMTbprqY67f",
+	"+t1lORuDGHBjUxl5t13PwTmUVB26bSM0JB6D+iv8gAeEkMY3l5ZSk8T+hCau6eIfhPaKZ1ip0YI3tODb",
+	"U6v8F7aN+u0L6fqpNtcFgIq3Z6TG9whEZz+cja5GSadSooV5c9U0j+/pUIjnJFPVrW9Htc78O9mzMOG+",
+	"a8FWnIpY4zCBao1kNPORHqWEff+Qfak4eWVu65piSA47BirrM5J+P50IWt66YFoO9MIqbtpbMp2DJL2z",
+	"tHSBJH0lkrFborBlj+3FKy0gGWSAS9RgIaHTUbx1bf6hjRrTXgWwCnudDgAET1kpKMkmWXODMnTMwyw6",
+	"LtGB9QbbEc/r6YmiIP3+JU64ptS7ogBM1+b5GkOWi9lFGHz6dTLWw14wRKoKtvPHxrPvolyAqwGmFGDe",
+	"XvqHCyTpOxEhhUDqMVrCegJ6BAd4baeLH+iY+8Oi0u61hcWnsgWM25FxHfJcEW7xPhiZm9zcg/xeFure",
+	"xc/TYPdv8ry3hHGM5qvwPIX16vEHk1hlsGWVhbha41iNY2fHsbcvkKRvVAr+ceznxaGa1xijZF2+hk8Y",
+	"kUTc4WHL0ekQy6LfCuDfiqsy4kJVVAwPeFBBc+6B0104v85gssHHFwxj52YNJjOdtTVYo2iNoq8qin5L",
+	"D5Ppn1RzWEO0drG+ylAkzt9RR98lmijmYiZqSbNw8b7b2lwUmbKCOPefkjXdibBP3E9trO2uVrPWALbv",
+	"80B3fNLvtoVT4u0A+JnesGgqacc67/ThQKmTDRKJz2TmWQudJ1gtU9ENa7ZEnd7nXZCwKwlf6yV0K62b",
+	"YrXKotiz1bEa1KPbqqIc4wTtOWnHvFTsBSvINBm1pqw15UVryjqS87Lo90x5R+YkbL7lkqXic9HfkE0O",
+	"F+/LpPLmYpQpHb5DDOHtfyJKoUAHU/iBGCBVeVzFcdIOano5YkCpOdTQXENz7cScGeTimw8EyB2cpgch",
+	"/6KSU8IhnBVgSt/1XgJ8Oz/z11QN/zOZwDXK1ihbo+y8UPYb3oLCEfZCodTUApOGc94E06/SBKNbqqLW",
+	"s7jQooeYex6gqV/mdcEgmbgQqwQi6aDwni62V4NmMWi+WniQ3Pd/zF92KxnelN3iyWvT9KMmT8R7saS8",
+	"4N63PAB5rB9Pq8r4BN+hVwmHwm4uXieudvrt3sbifWja3SxxPK/1Nlb4bTTl9pe4tqa67dWoftkDTzeK",
+	"s5qLTnXKPzzwyqW8sl51yJRObIusOb12hP0RxXf3GGbxgj1hDy1sch/SMY9ri7UVSQIsugZTXW6S7L5V",
+	"oZuxxcPjx3xc0S2eLePkJ60fsj7IBjcRdCFLHKzKeybUOTHmaLqI62NBuWKe6F9Q3FtpkU7Xj4jX3Lj8",
+	"LyTJxLKTT3OiC7V5OjfztA6GziMY+vabb74MZr528oS6RjEZwEQ1NBWbPrbwXnEbPG18f4faQLbo6bdr",
+	"iFbcRC+H0npNJ4hK9B1chWafo52ZuLatdsV/uVZliYSng3jJPg/tpPb4gj1U5kP2JWTf1d18EPAz3M2X",
+	"2hSLzXXS/NjvRaXeH8jvdflwrbxr5V0r71p5zwZtP7DPUNzH+lGa6hzb+Gbo9MnKMoilI15hWyseu5HE",
+	"ufhWsDKQ4xc4n0+cK31/bfVYV42try22vlZ7/GtogNcOa9UP799P7uK+cY9q0anSbhi1Xc8vUlXHKert",
+	"+Apvx7+gTfTCsBmzSlXbjvLsjgJ/GS4FPk9/OXHpcO0v1/5yRX8ZZVnamTw/88Si09QNLA18ju6L0DyY",
+	"mkOL31erGp7w6pmMbalblt3kVVwlOyZ1cVdpQXjBNXg56Qxxfq+uz+ZyFV21bI1OItrt2vEx6njV/CzN",
+	"m0t4wrk6hQ8esa4sLeUmb/DCmnTSQWRrlpZmzt08xTiifku9ODVWHdqDgDPClFpBtimfYH9tLSQpiotO",
+	"BjzXLtycW+RqpK2RtirS7vNOH7YnCwu1pUhckd5QhxWpQzUzh2aKkE2he55F0HNp7yy4ZrN22GsP4fXa",
+	"1d/odwpnj/6BuNwBVJTR57KQIPc2ijy7iHctCrujNAaX2uQrravih2dooDNbT3Non6v3/WtSYPsjr9xk",
+	"u+wLdfOkPFjcdEw/WMAH2lP68dBQHfqldgQjbhg6tJp+i9T5nTq/89rmd9QF2qPMcboJLo30PcU9yFFS",
+	"7cS4wPqJceigTM9wp3Z2NXOT/67WMrV1WUPxL6tp2NQtrN2zmA9lOhZp51UWBQHlY2U486MwGvjVRwg3",
+	"W+Kk6YJ70gFlcuNPoR9ExuBgzs3eBQFA8x13s9HqhM1cUv2AVxpnaXXCps3l4fShynSBQR2ofIkDlemL",
+	"wF/9COWr3AWTCQIm7jxP7SwdHuU90GX4qJ4rA8jvKtykXG/sl3hjZ24Gr3MPde6hcpZXO5jySdUb1Cvn",
+	"ITKoJV1LuNe/3LWUvwKnEn9xprO/UtfO105l7VTWTuWrB2FfaVfrG2qfkUNa87C8oT9zDXEiAhYSrwW3",
+	"5ZRi0i35YLlZVbewztDCOv/0s1ypOuVcp57q1FOdeqpTTxUjttpRxpkLjOISBryv3+wfPDEo1sXb8iT7",
+	"nKOWn6Yv/kenwnS0Mq7DM/ZIdmbitcJAzQjRMHlNXor+YzzG/yBJv36I8ZMzX/Y3ohPTwIaNq47A1qRv",
+	"YpbQRvpIzSKNjUds48aEeS7knbEsFeQ1XJnannnJ7RlcptMbNedBw+niTWcTkULxM0tQcuvAQejaqT/q",
+	"+C8hU/yg9mQN9rg29GpDrzb0akPvl2PoTcSZZ/JwxRG/viL3+srYYkrxLtlDrl/CUZLB0m9/KLdO4EBG",
+	"nja3ZBRaHNU7pEep3BXbFW17fD57lkfuRdd7QegH6jActosDPJKXMCv1ld811MQR7Lmc15Y5APPVysB9",
+	"r3JtajYjnAtk2/aVQh9pd3tPc2lbC/xOgjR1MzOcCXw5crHoohqrQaw/z5LEzcUZ6Yr8eVClbvDPybSw",
+	"bd5eyov4duk4gVhsj76Q6iJl5eSKKdzFTgK4Q3tjRmFNdNLlXaZSvZcOcBE60DGvo/rqWqTZdj38kNzr",
+	"ol2rtdXJ/zptezXH9D7PU6AFHtWp1jrVevZUa9VTjmdOt2avunKaTdItL+ZN3rh0lf/o5bxwqQ7b12nX",
+	"Ou16gXCGgiLvSE3ubLhZDk/VO8Br8YBHRxzNDsSe28Koq3CvCpFKqP8Zoepd8asaq2qsqrGq7jvI9B2c",
+	"Ba/47Zf7ybqTRLTEeAPogr1ZhWwS3JVg1Qva9rK9HkXd5cXFtt902ut+GC3/aulXS/bm6uZfBwAXm2Dd",
+	"xs0AAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
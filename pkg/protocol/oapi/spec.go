@@ -0,0 +1,8 @@
+package oapi
+
+import _ "embed"
+
+// Spec содержит исходную схему API в формате YAML.
+//
+//go:embed schema.yml
+var Spec []byte
@@ -0,0 +1,3 @@
+package oapiv2
+
+//go:generate ./oapigen.sh
@@ -0,0 +1,3 @@
+#!/usr/bin/env sh
+
+go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen -generate types,gin,spec,client -package oapiv2 -o ./oapi.gen.go ./schema.yml
\ No newline at end of file
@@ -8,13 +8,19 @@ import (
 	"github.com/google/uuid"
 )
 
+const (
+	RoleUser  = "user"
+	RoleAdmin = "admin"
+)
+
 type UserClaims struct {
 	ID       int64  `json:"id"`
 	UserName string `json:"username"`
+	Role     string `json:"role"`
 	jwt.RegisteredClaims
 }
 
-func NewUserClaims(id int64, username string, duration time.Duration) (*UserClaims, error) {
+func NewUserClaims(id int64, username string, role string, duration time.Duration) (*UserClaims, error) {
 	tokenID, err := uuid.NewRandom()
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate token ID: %w", err)
@@ -23,6 +29,7 @@ func NewUserClaims(id int64, username string, duration time.Duration) (*UserClai
 	return &UserClaims{
 		ID:       id,
 		UserName: username,
+		Role:     role,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ID:        tokenID.String(),
 			Subject:   username,
@@ -15,8 +15,9 @@ func NewJWTMaker(secretKey string) *JWTMaker {
 	return &JWTMaker{secretKey: secretKey}
 }
 
-func (maker *JWTMaker) CreateToken(id int64, username string, duration time.Duration) (string, *UserClaims, error) {
-	claims, err := NewUserClaims(id, username, duration)
+func (maker *JWTMaker) CreateToken(id int64, username string, role string, duration time.Duration) (
+	string, *UserClaims, error) {
+	claims, err := NewUserClaims(id, username, role, duration)
 	if err != nil {
 		return "", nil, err
 	}
@@ -55,11 +55,11 @@ func TestJWTMaker(t *testing.T) {
 			var err error
 
 			if tt.signingNone {
-				claims, _ = NewUserClaims(tt.id, tt.username, tt.duration)
+				claims, _ = NewUserClaims(tt.id, tt.username, RoleUser, tt.duration)
 				token := jwt.NewWithClaims(jwt.SigningMethodNone, claims)
 				tokenStr, _ = token.SignedString(jwt.UnsafeAllowNoneSignatureType)
 			} else {
-				tokenStr, claims, err = maker.CreateToken(tt.id, tt.username, tt.duration)
+				tokenStr, claims, err = maker.CreateToken(tt.id, tt.username, RoleUser, tt.duration)
 				assert.NoError(t, err, "CreateToken should not return an error")
 				assert.NotEmpty(t, tokenStr, "Token should not be empty")
 				assert.NotNil(t, claims, "Claims should not be nil")
@@ -75,6 +75,7 @@ func TestJWTMaker(t *testing.T) {
 				assert.NotNil(t, parsedClaims, "Claims should not be nil")
 				assert.Equal(t, tt.id, parsedClaims.ID, "UserID should match")
 				assert.Equal(t, tt.username, parsedClaims.UserName, "UserName should match")
+				assert.Equal(t, RoleUser, parsedClaims.Role, "Role should match")
 
 				if tt.shouldExpire {
 					assert.True(t, parsedClaims.ExpiresAt.Before(time.Now()), "Token should be expired")